@@ -0,0 +1,303 @@
+package v1
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Version is the version of the contract described by Document.
+const Version = "1.4.0"
+
+// OpenAPI is an OpenAPI 3 document, limited to what the public API uses.
+type OpenAPI struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+type PathItem struct {
+	Get  *Operation `json:"get,omitempty"`
+	Post *Operation `json:"post,omitempty"`
+}
+
+type Operation struct {
+	OperationID string                  `json:"operationId"`
+	Summary     string                  `json:"summary"`
+	Parameters  []*Parameter            `json:"parameters,omitempty"`
+	RequestBody *RequestBody            `json:"requestBody,omitempty"`
+	Responses   map[string]*APIResponse `json:"responses"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type APIResponse struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// Schema is a JSON schema as written in OpenAPI 3.0. Objects with properties
+// do not have other properties.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+}
+
+// componentRef is the prefix of the references to the schemas of the
+// components.
+const componentRef = "#/components/schemas/"
+
+// schemaGenerator builds the schemas of Go types, registering the structs as
+// components.
+type schemaGenerator struct {
+	components map[string]*Schema
+}
+
+// schemaOf returns the schema of the JSON serialization of a value of type t.
+// It panics on the types the contract does not use.
+func (g *schemaGenerator) schemaOf(t reflect.Type) *Schema {
+	if t == reflect.TypeOf(time.Time{}) {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.schemaOf(t.Elem())
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Slice:
+		return &Schema{Type: "array", Items: g.schemaOf(t.Elem())}
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			panic(fmt.Sprintf("openapi: unsupported map key of %s", t))
+		}
+		return &Schema{Type: "object", AdditionalProperties: g.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if _, ok := g.components[t.Name()]; !ok {
+			// Registered before its fields, for recursive types.
+			g.components[t.Name()] = nil
+			g.components[t.Name()] = g.structSchema(t)
+		}
+		return &Schema{Ref: componentRef + t.Name()}
+	}
+	panic(fmt.Sprintf("openapi: unsupported type %s", t))
+}
+
+// structSchema returns the schema of a struct from its json tags. Fields
+// without omitempty are always serialized, so they are required.
+func (g *schemaGenerator) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+		Required:   make([]string, 0),
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+
+		property := g.schemaOf(field.Type)
+		if description := field.Tag.Get("description"); description != "" {
+			property.Description = description
+		}
+		if enum := field.Tag.Get("enum"); enum != "" {
+			property.Enum = strings.Split(enum, ",")
+		}
+		schema.Properties[name] = property
+		if !strings.Contains(options, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+// Document returns the OpenAPI document of the public API, with the schemas
+// generated from the types of this package.
+func Document() *OpenAPI {
+	g := &schemaGenerator{components: make(map[string]*Schema)}
+	response := g.schemaOf(reflect.TypeOf(Response{}))
+	events := g.schemaOf(reflect.TypeOf(EventsRequest{}))
+
+	jsonResponse := func(description string) *APIResponse {
+		return &APIResponse{
+			Description: description,
+			Content:     map[string]*MediaType{"application/json": {Schema: response}},
+		}
+	}
+	htmlResponse := &APIResponse{
+		Description: "The messages as HTML, or an HTML comment describing the error.",
+		Content:     map[string]*MediaType{"text/html": {Schema: &Schema{Type: "string"}}},
+	}
+
+	identification := []*Parameter{
+		{Name: "X-Api-Key", In: "header", Description: "API key of the website.", Schema: &Schema{Type: "string"}},
+		{Name: "api_key", In: "query", Description: "API key of the website, when it cannot be sent as a header.", Schema: &Schema{Type: "string"}},
+		{Name: "Accept-Language", In: "header", Description: "Preferred languages of the client.", Schema: &Schema{Type: "string"}},
+		{Name: "lang", In: "query", Description: "Language of the messages, taking precedence over Accept-Language.", Schema: &Schema{Type: "string"}},
+		{Name: "Timezone", In: "header", Description: "IANA timezone of the client. Schedules are instants, so it does not change the messages served; an invalid timezone is still rejected.", Schema: &Schema{Type: "string"}},
+		{Name: "path", In: "query", Description: "Path of the page displaying the messages, read from the Referer header when absent.", Schema: &Schema{Type: "string"}},
+		{Name: "slot", In: "query", Description: "Slots of the website to return, separated by commas.", Schema: &Schema{Type: "string"}},
+		{Name: "X-Preview-Token", In: "header", Description: "Preview token generated from the message page, showing the messages as they will be displayed.", Schema: &Schema{Type: "string"}},
+		{Name: "preview", In: "query", Description: "Preview token, when it cannot be sent as a header.", Schema: &Schema{Type: "string"}},
+	}
+	keyInPath := &Parameter{Name: "key", In: "path", Description: "API key of the website.", Required: true, Schema: &Schema{Type: "string"}}
+	errorResponses := map[string]*APIResponse{
+		"400": jsonResponse("Invalid request, described by the error field."),
+		"401": jsonResponse("Invalid API key."),
+		"403": jsonResponse("Invalid, expired or revoked preview token."),
+		"429": jsonResponse("Too many requests, retry after the Retry-After header."),
+	}
+	withErrors := func(responses map[string]*APIResponse) map[string]*APIResponse {
+		for status, response := range errorResponses {
+			responses[status] = response
+		}
+		return responses
+	}
+
+	return &OpenAPI{
+		OpenAPI: "3.0.3",
+		Info: Info{
+			Title:       "Messages API",
+			Description: "Messages to display on a website, identified by its API key or by the Origin of the request.",
+			Version:     Version,
+		},
+		Servers: []Server{{URL: "/api/v1"}},
+		Paths: map[string]*PathItem{
+			"/messages": {Get: &Operation{
+				OperationID: "getMessages",
+				Summary:     "Active messages of the website",
+				Parameters: append(identification,
+					&Parameter{Name: "If-None-Match", In: "header", Description: "ETag of a previous response.", Schema: &Schema{Type: "string"}},
+				),
+				Responses: withErrors(map[string]*APIResponse{
+					"200": jsonResponse("The active messages, in the language served."),
+					"304": {Description: "The messages did not change."},
+					"500": jsonResponse("The messages could not be loaded, described by the error field. The response is not cached."),
+				}),
+			}},
+			"/messages/stream": {Get: &Operation{
+				OperationID: "streamMessages",
+				Summary:     "Server-Sent Events pushing the messages every time they change",
+				Parameters: append(identification,
+					&Parameter{Name: "Last-Event-ID", In: "header", Description: "Id of the last event received, skipping the initial event when the messages did not change.", Schema: &Schema{Type: "string"}},
+				),
+				Responses: withErrors(map[string]*APIResponse{
+					"200": {
+						Description: "A stream of messages events, whose data is a Response.",
+						Content:     map[string]*MediaType{"text/event-stream": {Schema: &Schema{Type: "string"}}},
+					},
+				}),
+			}},
+			"/messages/events": {Post: &Operation{
+				OperationID: "reportEvents",
+				Summary:     "Record the impressions, clicks and dismissals of messages",
+				Parameters:  identification[:2],
+				RequestBody: &RequestBody{
+					Required: true,
+					Content:  map[string]*MediaType{"application/json": {Schema: events}, "text/plain": {Schema: events}},
+				},
+				Responses: withErrors(map[string]*APIResponse{
+					"204": {Description: "The events were recorded."},
+					"500": jsonResponse("The events could not be recorded, described by the error field."),
+				}),
+			}},
+			"/messages/link": {Get: &Operation{
+				OperationID: "followLink",
+				Summary:     "Count a click on a tracked link and redirect to its destination",
+				Parameters: []*Parameter{
+					{Name: "m", In: "query", Description: "Id of the message.", Required: true, Schema: &Schema{Type: "integer", Format: "int64"}},
+					{Name: "w", In: "query", Description: "Id of the website.", Required: true, Schema: &Schema{Type: "integer", Format: "int64"}},
+					{Name: "u", In: "query", Description: "Destination of the link.", Required: true, Schema: &Schema{Type: "string"}},
+					{Name: "s", In: "query", Description: "Signature of the link.", Required: true, Schema: &Schema{Type: "string"}},
+				},
+				Responses: map[string]*APIResponse{
+					"302": {Description: "Redirect to the destination."},
+					"400": {Description: "The signature does not match.", Content: map[string]*MediaType{"text/plain": {Schema: &Schema{Type: "string"}}}},
+				},
+			}},
+			"/messages/fragment": {Get: &Operation{
+				OperationID: "getMessagesFragment",
+				Summary:     "Active messages as an HTML fragment",
+				Parameters:  identification,
+				Responses:   map[string]*APIResponse{"200": htmlResponse},
+			}},
+			"/messages/fragment/{key}": {Get: &Operation{
+				OperationID: "getMessagesFragmentByKey",
+				Summary:     "Active messages as an HTML fragment, for server-side includes",
+				Parameters:  append([]*Parameter{keyInPath}, identification[2:]...),
+				Responses:   map[string]*APIResponse{"200": htmlResponse},
+			}},
+			"/messages/page": {Get: &Operation{
+				OperationID: "getMessagesPage",
+				Summary:     "Active messages as a standalone HTML page",
+				Parameters:  identification,
+				Responses:   map[string]*APIResponse{"200": htmlResponse},
+			}},
+			"/messages/page/{key}": {Get: &Operation{
+				OperationID: "getMessagesPageByKey",
+				Summary:     "Active messages as a standalone HTML page, for iframes",
+				Parameters:  append([]*Parameter{keyInPath}, identification[2:]...),
+				Responses:   map[string]*APIResponse{"200": htmlResponse},
+			}},
+			"/openapi.json": {Get: &Operation{
+				OperationID: "getOpenAPI",
+				Summary:     "This document",
+				Responses: map[string]*APIResponse{
+					"200": {Description: "The OpenAPI document.", Content: map[string]*MediaType{"application/json": {Schema: &Schema{Type: "object"}}}},
+				},
+			}},
+		},
+		Components: Components{Schemas: g.components},
+	}
+}
@@ -0,0 +1,373 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite testdata/openapi.json from the Go types")
+
+// goldenPath is the published contract of version 1. It only changes on
+// purpose, with go test ./app/api/v1 -update, along with Version.
+var goldenPath = filepath.Join("testdata", "openapi.json")
+
+// firstReleasePath is the contract of version 1.0.0, which later versions 1.x
+// may only extend.
+var firstReleasePath = filepath.Join("testdata", "openapi-1.0.0.json")
+
+func TestDocumentMatchesContract(t *testing.T) {
+	got, err := json.MarshalIndent(Document(), "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, '\n')
+
+	if *update {
+		if err := os.WriteFile(goldenPath, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("the OpenAPI document generated from the types differs from %s: only additive changes are allowed in v1, with a minor bump of Version, regenerate it with -update", goldenPath)
+	}
+}
+
+func TestDocumentExtendsFirstRelease(t *testing.T) {
+	data, err := os.ReadFile(firstReleasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var first OpenAPI
+	if err := json.Unmarshal(data, &first); err != nil {
+		t.Fatal(err)
+	}
+
+	doc := Document()
+	if major, _, _ := strings.Cut(doc.Info.Version, "."); major != "1" {
+		t.Errorf("version %s is not a version 1.x", doc.Info.Version)
+	}
+	for _, err := range breakingChanges(&first, doc) {
+		t.Errorf("%s, add a new version instead", err)
+	}
+}
+
+// breakingChanges lists what a version 1.x removed or changed from the first
+// release: paths, operations, parameters, responses and schema properties may
+// only be added, and added parameters must be optional.
+func breakingChanges(first *OpenAPI, doc *OpenAPI) []error {
+	var errs []error
+	for path, item := range first.Paths {
+		current, ok := doc.Paths[path]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: removed", path))
+			continue
+		}
+		operations := []struct {
+			method           string
+			previous, actual *Operation
+		}{
+			{"GET", item.Get, current.Get},
+			{"POST", item.Post, current.Post},
+		}
+		for _, op := range operations {
+			if op.previous == nil {
+				continue
+			}
+			where := op.method + " " + path
+			if op.actual == nil {
+				errs = append(errs, fmt.Errorf("%s: removed", where))
+				continue
+			}
+			errs = append(errs, operationChanges(where, op.previous, op.actual)...)
+		}
+	}
+	for name, schema := range first.Components.Schemas {
+		current, ok := doc.Components.Schemas[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("schema %s: removed", name))
+			continue
+		}
+		errs = append(errs, schemaChanges("schema "+name, schema, current)...)
+	}
+	return errs
+}
+
+func operationChanges(where string, previous *Operation, actual *Operation) []error {
+	var errs []error
+	for _, parameter := range actual.Parameters {
+		i := slices.IndexFunc(previous.Parameters, func(p *Parameter) bool {
+			return p.Name == parameter.Name && p.In == parameter.In
+		})
+		if i < 0 && parameter.Required {
+			errs = append(errs, fmt.Errorf("%s: new parameter %s is required", where, parameter.Name))
+		}
+	}
+	for _, parameter := range previous.Parameters {
+		i := slices.IndexFunc(actual.Parameters, func(p *Parameter) bool {
+			return p.Name == parameter.Name && p.In == parameter.In
+		})
+		if i < 0 {
+			errs = append(errs, fmt.Errorf("%s: parameter %s removed", where, parameter.Name))
+			continue
+		}
+		if actual.Parameters[i].Required && !parameter.Required {
+			errs = append(errs, fmt.Errorf("%s: parameter %s became required", where, parameter.Name))
+		}
+		errs = append(errs, schemaChanges(where+" parameter "+parameter.Name, parameter.Schema, actual.Parameters[i].Schema)...)
+	}
+	for status, response := range previous.Responses {
+		current, ok := actual.Responses[status]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: response %s removed", where, status))
+			continue
+		}
+		for mediaType, content := range response.Content {
+			if currentContent, ok := current.Content[mediaType]; !ok {
+				errs = append(errs, fmt.Errorf("%s: response %s %s removed", where, status, mediaType))
+			} else {
+				errs = append(errs, schemaChanges(where+" response "+status, content.Schema, currentContent.Schema)...)
+			}
+		}
+	}
+	if previous.RequestBody != nil {
+		if actual.RequestBody == nil {
+			errs = append(errs, fmt.Errorf("%s: request body removed", where))
+		} else {
+			for mediaType, content := range previous.RequestBody.Content {
+				if currentContent, ok := actual.RequestBody.Content[mediaType]; !ok {
+					errs = append(errs, fmt.Errorf("%s: request body %s removed", where, mediaType))
+				} else {
+					errs = append(errs, schemaChanges(where+" request body", content.Schema, currentContent.Schema)...)
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// schemaChanges compares two versions of a schema. Descriptions may change,
+// the references, types and formats may not; properties, their required
+// flags and enum values are kept.
+func schemaChanges(where string, previous *Schema, actual *Schema) []error {
+	if previous == nil || actual == nil {
+		if previous != actual {
+			return []error{fmt.Errorf("%s: schema changed", where)}
+		}
+		return nil
+	}
+	if previous.Ref != actual.Ref || previous.Type != actual.Type || previous.Format != actual.Format {
+		return []error{fmt.Errorf("%s: changed from %s%s %s to %s%s %s", where, previous.Ref, previous.Type, previous.Format, actual.Ref, actual.Type, actual.Format)}
+	}
+
+	var errs []error
+	for _, value := range previous.Enum {
+		if !slices.Contains(actual.Enum, value) {
+			errs = append(errs, fmt.Errorf("%s: enum value %s removed", where, value))
+		}
+	}
+	for _, name := range previous.Required {
+		if !slices.Contains(actual.Required, name) {
+			errs = append(errs, fmt.Errorf("%s: property %s no longer required", where, name))
+		}
+	}
+	for name, property := range previous.Properties {
+		current, ok := actual.Properties[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: property %s removed", where, name))
+			continue
+		}
+		errs = append(errs, schemaChanges(where+"."+name, property, current)...)
+	}
+	errs = append(errs, schemaChanges(where+" items", previous.Items, actual.Items)...)
+	errs = append(errs, schemaChanges(where+" values", previous.AdditionalProperties, actual.AdditionalProperties)...)
+	return errs
+}
+
+func TestSerializedPayloadsMatchDocument(t *testing.T) {
+	doc := Document()
+	from := time.Date(2024, 7, 1, 13, 0, 0, 0, time.UTC)
+	message := Message{
+		ID:          42,
+		Revision:    3,
+		Title:       "Important Update",
+		Message:     "<p>Here is an important update...</p>",
+		Type:        "info",
+		Slot:        "header",
+		Language:    "en",
+		DisplayFrom: from,
+		DisplayTo:   from.Add(14 * 24 * time.Hour),
+	}
+
+	tests := []struct {
+		name   string
+		schema string
+		value  any
+	}{
+		{
+			name:   "success",
+			schema: "Response",
+			value: Response{
+				Origin:   "https://example.com",
+				Language: "en",
+				Messages: []Message{message},
+				Slots:    map[string][]Message{"header": {message}},
+			},
+		},
+		{
+			name:   "empty",
+			schema: "Response",
+			value: Response{
+				Origin:   "https://example.com",
+				Language: "en",
+				Messages: make([]Message, 0),
+				Slots:    make(map[string][]Message),
+			},
+		},
+		{
+			name:   "error",
+			schema: "Response",
+			value: Response{
+				Origin:   "https://example.com",
+				Messages: make([]Message, 0),
+				Slots:    make(map[string][]Message),
+				Error:    "Invalid API key",
+			},
+		},
+		{
+			name:   "events",
+			schema: "EventsRequest",
+			value: EventsRequest{Events: []Event{
+				{Type: "impression", MessageId: 42, Language: "en"},
+				{Type: "dismissal", MessageId: 42, Language: "fr"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var value any
+			if err := json.Unmarshal(data, &value); err != nil {
+				t.Fatal(err)
+			}
+			if err := validate(doc, &Schema{Ref: componentRef + tt.schema}, value, "$"); err != nil {
+				t.Errorf("%s does not match the %s schema: %v", data, tt.schema, err)
+			}
+		})
+	}
+}
+
+func TestValidateRejectsDivergences(t *testing.T) {
+	doc := Document()
+	schema := &Schema{Ref: componentRef + "Response"}
+
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"missing required field", `{"domain":"example.com","messages":[]}`},
+		{"unknown field", `{"domain":"example.com","messages":[],"slots":{},"extra":1}`},
+		{"null list", `{"domain":"example.com","messages":null,"slots":{}}`},
+		{"wrong type", `{"domain":"example.com","messages":[{"id":"42"}],"slots":{}}`},
+		{"unknown message type", `{"domain":"example.com","messages":[],"slots":{"default":[{"id":1,"revision":1,"title":"","message":"","type":"notice","slot":"default","language":"en","display_from":"2024-07-01T13:00:00Z","display_to":"2024-07-01T13:00:00Z"}]}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var value any
+			if err := json.Unmarshal([]byte(tt.json), &value); err != nil {
+				t.Fatal(err)
+			}
+			if err := validate(doc, schema, value, "$"); err == nil {
+				t.Errorf("%s should not match the Response schema", tt.json)
+			}
+		})
+	}
+}
+
+// validate checks a decoded JSON value against a schema of the document.
+func validate(doc *OpenAPI, schema *Schema, value any, path string) error {
+	if schema.Ref != "" {
+		component, ok := doc.Components.Schemas[strings.TrimPrefix(schema.Ref, componentRef)]
+		if !ok {
+			return fmt.Errorf("%s: unknown schema %s", path, schema.Ref)
+		}
+		return validate(doc, component, value, path)
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: expected an object, got %T", path, value)
+		}
+		for _, name := range schema.Required {
+			if _, ok := object[name]; !ok {
+				return fmt.Errorf("%s: missing %s", path, name)
+			}
+		}
+		for name, property := range object {
+			propertySchema, ok := schema.Properties[name]
+			if !ok {
+				propertySchema = schema.AdditionalProperties
+			}
+			if propertySchema == nil {
+				return fmt.Errorf("%s: unexpected property %s", path, name)
+			}
+			if err := validate(doc, propertySchema, property, path+"."+name); err != nil {
+				return err
+			}
+		}
+	case "array":
+		array, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s: expected an array, got %T", path, value)
+		}
+		for i, item := range array {
+			if err := validate(doc, schema.Items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s: expected a string, got %T", path, value)
+		}
+		if schema.Format == "date-time" {
+			if _, err := time.Parse(time.RFC3339, str); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+		if len(schema.Enum) > 0 && !slices.Contains(schema.Enum, str) {
+			return fmt.Errorf("%s: %q is not one of %v", path, str, schema.Enum)
+		}
+	case "integer":
+		number, ok := value.(float64)
+		if !ok || number != float64(int64(number)) {
+			return fmt.Errorf("%s: expected an integer, got %v", path, value)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: expected a boolean, got %T", path, value)
+		}
+	default:
+		return fmt.Errorf("%s: unsupported schema type %q", path, schema.Type)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// Package v1 holds the contract of version 1 of the public API, served under
+// /api/v1 and at the legacy /api paths.
+//
+// The types of this package are serialized as is by the handlers. The contract
+// only grows: optional parameters, responses and fields may be added with a
+// minor bump of Version, while removing, renaming or retyping anything needs a
+// new version. The OpenAPI document generated from the types is compared with
+// testdata/openapi.json, and must still describe everything of the first
+// release, testdata/openapi-1.0.0.json.
+package v1
+
+import "time"
+
+// Message is a message as served by the public API. The revision is bumped
+// every time the message is edited, so clients can tell a message they already
+// displayed or dismissed from its newer versions.
+type Message struct {
+	ID          int64     `json:"id"`
+	Revision    int64     `json:"revision"`
+	Title       string    `json:"title"`
+	Message     string    `json:"message" description:"HTML content, sanitised for the website"`
+	Type        string    `json:"type" enum:"info,warning,danger"`
+	Slot        string    `json:"slot"`
+	Language    string    `json:"language"`
+	DisplayFrom time.Time `json:"display_from" description:"Start of the display period, or of the current occurrence or daily window of the message"`
+	DisplayTo   time.Time `json:"display_to" description:"End of the display period, or of the current occurrence or daily window of the message"`
+}
+
+// Response is the payload of the public API. Slots groups the messages by the
+// slot they occupy on the website.
+type Response struct {
+	Origin   string               `json:"domain"`
+	Language string               `json:"language,omitempty"`
+	Messages []Message            `json:"messages"`
+	Slots    map[string][]Message `json:"slots"`
+	Error    string               `json:"error,omitempty"`
+}
+
+// Event is an event reported by a client about a message it displays.
+type Event struct {
+	Type      string `json:"type" enum:"impression,click,dismissal"`
+	MessageId int64  `json:"id"`
+	Language  string `json:"language"`
+}
+
+// EventsRequest is the body of the events endpoint.
+type EventsRequest struct {
+	Events []Event `json:"events"`
+}
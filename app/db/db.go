@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"log"
 	"os"
+	"testing"
 
 	"github.com/friendsofgo/errors"
 	_ "github.com/mattn/go-sqlite3"
@@ -14,6 +15,11 @@ import (
 var Query *sql.DB
 
 func init() {
+	// Test binaries open their own database with Open.
+	if testing.Testing() {
+		return
+	}
+
 	config := struct {
 		Driver string
 		Name   string
@@ -23,10 +29,20 @@ func init() {
 	}
 
 	// For SQLite, the DSN is just the path to the database file
-	dsn := config.Name
-	db, err := sql.Open(config.Driver, dsn)
+	if err := Open(config.Driver, config.Name); err != nil {
+		log.Fatal(err)
+	}
+
+	if os.Getenv("APP_ENV") == "development" {
+		boil.DebugMode = true
+	}
+}
+
+// Open connects to a database and makes it the database of the models.
+func Open(driver string, dsn string) error {
+	db, err := sql.Open(driver, dsn)
 	if err != nil {
-		log.Fatal(errors.Wrap(err, "failed to open database connection"))
+		return errors.Wrap(err, "failed to open database connection")
 	}
 
 	// Set the global Query variable to the initialized database connection
@@ -36,12 +52,8 @@ func init() {
 	boil.SetDB(Query)
 
 	// Check if the connection is valid
-	err = Query.Ping()
-	if err != nil {
-		log.Fatal(errors.Wrap(err, "failed to ping database"))
-	}
-
-	if os.Getenv("APP_ENV") == "development" {
-		boil.DebugMode = true
+	if err := Query.Ping(); err != nil {
+		return errors.Wrap(err, "failed to ping database")
 	}
+	return nil
 }
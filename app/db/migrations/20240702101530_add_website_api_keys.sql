@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    if not exists website_api_keys (
+        id integer primary key autoincrement not null,
+        website_id integer not null references websites (id),
+        name text not null,
+        key_prefix text not null,
+        key_hash text unique not null,
+        created_at DATETIME NOT NULL,
+        last_used_at DATETIME,
+        revoked_at DATETIME
+    );
+
+ALTER TABLE websites
+ADD COLUMN allow_origin_lookup BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Existing websites are only known by their Origin, keep them reachable.
+UPDATE websites
+SET
+    allow_origin_lookup = TRUE;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE website_api_keys;
+
+ALTER TABLE websites
+DROP COLUMN allow_origin_lookup;
+
+-- +goose StatementEnd
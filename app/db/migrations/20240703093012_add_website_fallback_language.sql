@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE websites
+ADD COLUMN fallback_language TEXT NOT NULL DEFAULT '';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE websites
+DROP COLUMN fallback_language;
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    if not exists languages (
+        id integer primary key autoincrement not null,
+        code text unique not null,
+        name text not null,
+        direction text not null default 'ltr',
+        enabled BOOLEAN NOT NULL DEFAULT TRUE,
+        created_at DATETIME NOT NULL,
+        updated_at DATETIME NOT NULL
+    );
+
+-- The languages supported before the catalogue existed.
+INSERT INTO
+    languages (code, name, direction, enabled, created_at, updated_at)
+VALUES
+    ('en', 'English', 'ltr', TRUE, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('fr', 'Français', 'ltr', TRUE, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE languages;
+
+-- +goose StatementEnd
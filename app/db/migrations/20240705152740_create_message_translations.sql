@@ -0,0 +1,79 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    if not exists message_translations (
+        id integer primary key autoincrement not null,
+        message_id integer not null references messages (id),
+        language text not null,
+        title text not null,
+        content text not null,
+        created_at DATETIME NOT NULL,
+        updated_at DATETIME NOT NULL,
+        UNIQUE (message_id, language)
+    );
+
+-- Every existing message becomes a message with a single translation.
+INSERT INTO
+    message_translations (message_id, language, title, content, created_at, updated_at)
+SELECT
+    id,
+    language,
+    title,
+    message,
+    created_at,
+    updated_at
+FROM
+    messages;
+
+ALTER TABLE messages
+DROP COLUMN title;
+
+ALTER TABLE messages
+DROP COLUMN message;
+
+ALTER TABLE messages
+DROP COLUMN language;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE messages
+ADD COLUMN title text not null default '';
+
+ALTER TABLE messages
+ADD COLUMN message text not null default '';
+
+ALTER TABLE messages
+ADD COLUMN language text not null default 'en';
+
+-- Messages keep their first translation only.
+UPDATE messages
+SET
+    title = t.title,
+    message = t.content,
+    language = t.language
+FROM
+    (
+        SELECT
+            message_id,
+            language,
+            title,
+            content
+        FROM
+            message_translations
+        WHERE
+            id IN (
+                SELECT
+                    MIN(id)
+                FROM
+                    message_translations
+                GROUP BY
+                    message_id
+            )
+    ) AS t
+WHERE
+    messages.id = t.message_id;
+
+DROP TABLE message_translations;
+
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE websites
+ADD COLUMN forbid_links BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE websites
+ADD COLUMN forbid_images BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE websites
+ADD COLUMN allowed_url_schemes TEXT NOT NULL DEFAULT 'http,https,mailto';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE websites
+DROP COLUMN forbid_links;
+
+ALTER TABLE websites
+DROP COLUMN forbid_images;
+
+ALTER TABLE websites
+DROP COLUMN allowed_url_schemes;
+
+-- +goose StatementEnd
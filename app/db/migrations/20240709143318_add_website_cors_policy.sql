@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE websites
+ADD COLUMN cors_origins TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE websites
+ADD COLUMN cors_max_age INTEGER NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE websites
+DROP COLUMN cors_origins;
+
+ALTER TABLE websites
+DROP COLUMN cors_max_age;
+
+-- +goose StatementEnd
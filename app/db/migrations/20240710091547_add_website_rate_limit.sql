@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE websites
+ADD COLUMN rate_limit INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE websites
+ADD COLUMN rate_burst INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE websites
+ADD COLUMN rejected_requests INTEGER NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE websites
+DROP COLUMN rate_limit;
+
+ALTER TABLE websites
+DROP COLUMN rate_burst;
+
+ALTER TABLE websites
+DROP COLUMN rejected_requests;
+
+-- +goose StatementEnd
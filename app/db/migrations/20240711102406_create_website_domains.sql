@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    if not exists website_domains (
+        id integer primary key autoincrement not null,
+        website_id integer not null references websites (id),
+        pattern text unique not null,
+        created_at DATETIME NOT NULL
+    );
+
+CREATE INDEX website_domains_website_id ON website_domains (website_id);
+
+-- The domain of every website becomes its first domain. Websites sharing a
+-- domain keep it only for the oldest one.
+INSERT OR IGNORE INTO
+    website_domains (website_id, pattern, created_at)
+SELECT
+    id,
+    url,
+    CURRENT_TIMESTAMP
+FROM
+    websites
+ORDER BY
+    id ASC;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE website_domains;
+
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE websites_messages
+ADD COLUMN include_paths TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE websites_messages
+ADD COLUMN exclude_paths TEXT NOT NULL DEFAULT '';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE websites_messages
+DROP COLUMN include_paths;
+
+ALTER TABLE websites_messages
+DROP COLUMN exclude_paths;
+
+-- +goose StatementEnd
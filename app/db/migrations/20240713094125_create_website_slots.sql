@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    if not exists website_slots (
+        id integer primary key autoincrement not null,
+        website_id integer not null references websites (id),
+        name text not null,
+        created_at DATETIME NOT NULL,
+        UNIQUE (website_id, name)
+    );
+
+ALTER TABLE websites_messages
+ADD COLUMN slot TEXT NOT NULL DEFAULT '';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE websites_messages
+DROP COLUMN slot;
+
+DROP TABLE website_slots;
+
+-- +goose StatementEnd
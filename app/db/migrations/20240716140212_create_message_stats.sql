@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    if not exists message_stats (
+        id integer primary key autoincrement not null,
+        message_id integer not null references messages (id),
+        website_id integer not null references websites (id),
+        language text not null,
+        hour DATETIME NOT NULL,
+        impressions integer not null default 0,
+        clicks integer not null default 0,
+        dismissals integer not null default 0,
+        UNIQUE (message_id, website_id, language, hour)
+    );
+
+CREATE INDEX message_stats_website_id ON message_stats (website_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE message_stats;
+
+-- +goose StatementEnd
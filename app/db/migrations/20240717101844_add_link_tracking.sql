@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE websites
+ADD COLUMN link_tracking BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE
+    if not exists message_link_clicks (
+        id integer primary key autoincrement not null,
+        message_id integer not null references messages (id),
+        website_id integer not null references websites (id),
+        url text not null,
+        hour DATETIME NOT NULL,
+        clicks integer not null default 0,
+        UNIQUE (message_id, website_id, url, hour)
+    );
+
+CREATE INDEX message_link_clicks_website_id ON message_link_clicks (website_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE message_link_clicks;
+
+ALTER TABLE websites
+DROP COLUMN link_tracking;
+
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    if not exists preview_tokens (
+        id integer primary key autoincrement not null,
+        message_id integer not null references messages (id),
+        website_id integer references websites (id),
+        preview_at DATETIME,
+        token_prefix text not null,
+        token_hash text unique not null,
+        created_at DATETIME NOT NULL,
+        expires_at DATETIME NOT NULL,
+        last_used_at DATETIME,
+        revoked_at DATETIME
+    );
+
+CREATE INDEX preview_tokens_message_id ON preview_tokens (message_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE preview_tokens;
+
+-- +goose StatementEnd
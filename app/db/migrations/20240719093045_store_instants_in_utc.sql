@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Instants were stored with the offset of the timezone they were written in,
+-- and SQLite compares them as text: they are rewritten in UTC, in the format
+-- of the driver.
+UPDATE messages
+SET
+    display_from = strftime ('%Y-%m-%d %H:%M:%S', display_from) || '+00:00',
+    display_to = strftime ('%Y-%m-%d %H:%M:%S', display_to) || '+00:00';
+
+UPDATE preview_tokens
+SET
+    preview_at = strftime ('%Y-%m-%d %H:%M:%S', preview_at) || '+00:00'
+WHERE
+    preview_at IS NOT NULL;
+
+UPDATE preview_tokens
+SET
+    expires_at = strftime ('%Y-%m-%d %H:%M:%S', expires_at) || '+00:00';
+
+UPDATE sessions
+SET
+    expires_at = strftime ('%Y-%m-%d %H:%M:%S', expires_at) || '+00:00';
+
+-- Timezone of the schedule of a message. Existing messages were written in
+-- the TIMEZONE of the server, used when empty.
+ALTER TABLE messages
+ADD COLUMN timezone text NOT NULL DEFAULT '';
+
+-- Timezone of the dates displayed to a user, the TIMEZONE of the server when
+-- empty.
+ALTER TABLE users
+ADD COLUMN timezone text NOT NULL DEFAULT '';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+-- The instants remain in UTC, which the previous versions read as well.
+ALTER TABLE users
+DROP COLUMN timezone;
+
+ALTER TABLE messages
+DROP COLUMN timezone;
+
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Recurrence rule of a message, a subset of the RRULE of RFC 5545. Messages
+-- without rule are displayed for their whole display period.
+ALTER TABLE messages
+ADD COLUMN recurrence_rule text NOT NULL DEFAULT '';
+
+-- Days of the occurrences skipped, as 2024-12-25, separated by commas.
+ALTER TABLE messages
+ADD COLUMN recurrence_exceptions text NOT NULL DEFAULT '';
+
+-- Minutes each occurrence is displayed for.
+ALTER TABLE messages
+ADD COLUMN recurrence_duration integer NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE messages
+DROP COLUMN recurrence_duration;
+
+ALTER TABLE messages
+DROP COLUMN recurrence_exceptions;
+
+ALTER TABLE messages
+DROP COLUMN recurrence_rule;
+
+-- +goose StatementEnd
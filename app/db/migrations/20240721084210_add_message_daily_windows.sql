@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Hours of the day a message is displayed, as 18:00, read in the timezone of
+-- each website. Empty for the whole day; a window ending before it starts
+-- ends the next day.
+ALTER TABLE messages
+ADD COLUMN daily_from text NOT NULL DEFAULT '';
+
+ALTER TABLE messages
+ADD COLUMN daily_to text NOT NULL DEFAULT '';
+
+-- Days of the week a message is displayed, as MO,TU,WE,TH,FR. Empty for
+-- every day.
+ALTER TABLE messages
+ADD COLUMN weekdays text NOT NULL DEFAULT '';
+
+-- Timezone the daily windows of the messages of a website are read in, the
+-- TIMEZONE of the server when empty.
+ALTER TABLE websites
+ADD COLUMN timezone text NOT NULL DEFAULT '';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE websites
+DROP COLUMN timezone;
+
+ALTER TABLE messages
+DROP COLUMN weekdays;
+
+ALTER TABLE messages
+DROP COLUMN daily_to;
+
+ALTER TABLE messages
+DROP COLUMN daily_from;
+
+-- +goose StatementEnd
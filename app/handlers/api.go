@@ -1,132 +1,425 @@
 package handlers
 
 import (
+	"context"
+	"log/slog"
+	v1 "messages/app/api/v1"
 	"messages/app/db"
 	"messages/app/helpers"
 	"messages/app/models"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/anthdm/superkit/kit"
+	"github.com/go-chi/chi/v5"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"github.com/gomarkdown/markdown"
+	"github.com/gomarkdown/markdown/ast"
 	"github.com/gomarkdown/markdown/html"
 	"github.com/gomarkdown/markdown/parser"
 )
 
-type Message struct {
-	Title   string `json:"title"`
-	Message string `json:"message"`
-	Type    string `json:"type"`
+// Message is a message as served by the public API, whose contract is frozen
+// in the v1 package.
+type Message = v1.Message
+
+// Response is the payload of the public API.
+type Response = v1.Response
+
+// defaultApiLanguage is served when neither the request nor the website
+// designate a supported language.
+const defaultApiLanguage = "en"
+
+// apiRequest holds the validated parameters of a public API request.
+type apiRequest struct {
+	origin string
+	// languages lists the supported languages to try, by order of preference,
+	// ending with the fallback language of the website.
+	languages []string
+	// preferredLanguages lists the supported languages requested.
+	preferredLanguages []string
+	// apiKey is the API key of the request, if any.
+	apiKey string
+	// path is the path of the page displaying the messages, if known.
+	path string
+	// slots restricts the messages to some slots of the website, if any.
+	slots   []string
+	website *models.Website
+	// preview is set when the request carries a valid preview token, and by
+	// simulations, to serve the messages displayed at another instant.
+	preview *apiPreview
 }
 
-type Response struct {
-	Origin   string    `json:"domain"`
-	Messages []Message `json:"messages"`
-	Error    string    `json:"error,omitempty"`
+// apiError is a client error returned by the public API.
+type apiError struct {
+	status  int
+	message string
 }
 
-func HandleApi(kit *kit.Kit) error {
-	loc, _ := time.LoadLocation(kit.Getenv("TIMEZONE", "America/Toronto"))
+func (e *apiError) Error() string {
+	return e.message
+}
 
+func HandleApi(kit *kit.Kit) error {
 	request := kit.Request
 	kit.Response.Header().Set("Content-Type", "application/json")
+	kit.Response.Header().Set("Vary", apiVaryHeaders)
 
-	lang := request.Header.Get("Accept-Language")
-	if lang == "" {
-		lang = "en"
-	}
-
-	originDomain := request.Header.Get("Origin")
 	response := Response{
-		Origin:   originDomain,
+		Origin:   request.Header.Get("Origin"),
 		Messages: make([]Message, 0),
+		Slots:    make(map[string][]Message),
+	}
+
+	apiReq, apiErr := parseApiRequest(kit)
+	if apiErr != nil {
+		response.Error = apiErr.message
+		kit.JSON(apiErr.status, response)
+		return nil
+	}
+	response.Origin = apiReq.origin
+
+	now := time.Now().UTC()
+	// A failure is not cached, so it is not taken for an empty list of
+	// messages until the cache expires.
+	entry, messages, lang, err := getNegotiatedMessagesEntry(kit.Request.Context(), apiReq, now)
+	if err != nil {
+		slog.Error("failed to load the messages of the website", "website", apiReq.website.ID, "err", err.Error())
+		kit.Response.Header().Set("Cache-Control", "no-store")
+		response.Error = "Failed to load messages"
+		kit.JSON(500, response)
+		return nil
 	}
+	kit.Response.Header().Set("Content-Language", lang)
+
+	if apiReq.preview != nil {
+		setPreviewCacheHeaders(kit.Response)
+	} else {
+		etag := computeMessagesETag(apiReq.variant(), entry.fingerprint)
+		setCacheHeaders(kit.Response, etag, now, entry.nextBoundary)
+		if etagMatches(request.Header.Get("If-None-Match"), etag) {
+			kit.Response.WriteHeader(304)
+			return nil
+		}
+	}
+
+	response.Language = lang
+	response.Messages = messages
+	response.Slots = groupMessagesBySlot(messages)
+	kit.JSON(200, response)
+	return nil
+}
+
+// renderApiJSON writes a JSON payload of the public API. The Content-Type is
+// set first, as kit.JSON writes the status before its headers.
+func renderApiJSON(kit *kit.Kit, status int, payload any) error {
+	kit.Response.Header().Set("Content-Type", "application/json")
+	return kit.JSON(status, payload)
+}
 
-	timezone := request.Header.Get("Timezone")
-	if timezone != "" {
+// variant identifies the requests getting the same messages from a cache
+// entry, for their ETag.
+func (r *apiRequest) variant() string {
+	return r.origin + "|" + r.path + "|" + strings.Join(r.slots, ",")
+}
+
+// getNegotiatedMessagesEntry returns the messages targeting the page and the
+// slots of the request in the first language of the request having any, along
+// with their cache entry and that language. When no language has any, the
+// empty list of the preferred language is returned. Previews return the
+// messages displayed at their instant, bypassing the cache.
+func getNegotiatedMessagesEntry(ctx context.Context, apiReq *apiRequest, now time.Time) (*messagesCacheEntry, []Message, string, error) {
+	var preferredEntry *messagesCacheEntry
+	var preferredMessages []Message
+	for _, lang := range apiReq.languages {
+		var entry *messagesCacheEntry
 		var err error
-		loc, err = time.LoadLocation(timezone)
+		if apiReq.preview != nil {
+			entry, err = buildMessagesEntry(ctx, apiReq.website, lang, apiReq.preview.trackLinks, apiReq.preview.at)
+		} else {
+			entry, err = getActiveMessagesEntry(ctx, apiReq.website, lang, now)
+		}
 		if err != nil {
-			response.Error = "Invalid timezone"
-			kit.JSON(400, response)
-			return nil
+			return nil, nil, "", err
+		}
+		messages := filterMessagesBySlot(entry.messagesForPath(apiReq.path), apiReq.slots)
+		if apiReq.preview != nil {
+			messages = apiReq.preview.filter(messages)
+		}
+		if len(messages) > 0 {
+			return entry, messages, lang, nil
+		}
+		if preferredEntry == nil {
+			preferredEntry = entry
+			preferredMessages = messages
 		}
 	}
 
-	if !helpers.IsValidDomain(originDomain) {
-		response.Error = "Invalid domain"
-		kit.JSON(400, response)
-		return nil
+	return preferredEntry, preferredMessages, apiReq.languages[0], nil
+}
+
+// parseApiRequest validates the timezone, the language and the website of a
+// public API request.
+func parseApiRequest(kit *kit.Kit) (*apiRequest, *apiError) {
+	request := kit.Request
+	apiReq := &apiRequest{}
+
+	// Browsers omit the Origin header on page loads, such as the page endpoint
+	// displayed in an iframe, where the Referer designates the website.
+	originHeader := request.Header.Get("Origin")
+	if originHeader == "" {
+		originHeader = request.Header.Get("Referer")
+	}
+	origin, originOk := helpers.ParseOrigin(originHeader)
+	if originOk {
+		apiReq.origin = origin.String()
 	}
 
-	if !IsValidLanguage(lang) {
-		response.Error = "Invalid language"
-		kit.JSON(400, response)
-		return nil
+	// The path query parameter designates the page displaying the messages,
+	// otherwise the Referer does.
+	// Both are normalized, so they match the same path patterns.
+	if path := request.URL.Query().Get("path"); path != "" {
+		normalized, ok := helpers.NormalizePath(path)
+		if !ok {
+			return nil, &apiError{status: 400, message: "Invalid path"}
+		}
+		apiReq.path = normalized
+	} else if referer, err := url.Parse(request.Header.Get("Referer")); err == nil && referer.Host != "" {
+		apiReq.path, _ = helpers.NormalizePath(referer.EscapedPath())
+		if apiReq.path == "" {
+			apiReq.path = "/"
+		}
+	}
+
+	// The slot query parameter lists the slots to return, separated by commas.
+	for _, slot := range strings.FieldsFunc(request.URL.Query().Get("slot"), isListSeparator) {
+		if !isValidSlotName(slot) {
+			return nil, &apiError{status: 400, message: "Invalid slot"}
+		}
+		if !slices.Contains(apiReq.slots, slot) {
+			apiReq.slots = append(apiReq.slots, slot)
+		}
 	}
 
-	dbWebsite, err := models.Websites(
-		models.WebsiteWhere.URL.EQ(originDomain),
-	).One(kit.Request.Context(), db.Query)
+	// The lang query parameter takes precedence and accepts the same syntax as
+	// the header, for clients unable to set it.
+	acceptLanguage := request.URL.Query().Get("lang")
+	if acceptLanguage == "" {
+		acceptLanguage = request.Header.Get("Accept-Language")
+	}
+	apiReq.preferredLanguages = helpers.MatchLanguages(helpers.ParseAcceptLanguage(acceptLanguage), IsValidLanguage)
+
+	// Schedules are stored as instants, so the timezone of the client does not
+	// change the messages served. The header remains validated, as in v1.
+	if timezone := request.Header.Get("Timezone"); timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return nil, &apiError{status: 400, message: "Invalid timezone"}
+		}
+	}
+
+	apiKey := helpers.GetAPIKey(request)
+	if apiKey == "" {
+		// Server-side includes cannot always set headers or query parameters.
+		apiKey = chi.URLParam(request, "key")
+	}
+
+	// Clients exhausting their bucket with unknown credentials are rejected
+	// before their credential is looked up.
+	if apiErr := checkApiClient(kit); apiErr != nil {
+		return nil, apiErr
+	}
+
+	if apiKey != "" {
+		apiReq.apiKey = apiKey
+		var err error
+		apiReq.website, err = findWebsiteByApiKey(request.Context(), apiKey)
+		if err != nil {
+			if apiErr := limitApiRequest(kit, nil); apiErr != nil {
+				return nil, apiErr
+			}
+			return nil, &apiError{status: 401, message: "Invalid API key"}
+		}
+		if apiErr := limitApiRequest(kit, apiReq.website); apiErr != nil {
+			return nil, apiErr
+		}
+		apiReq.languages = languageChain(apiReq.preferredLanguages, apiReq.website.FallbackLanguage)
+		restrictApiCors(kit.Response, request, apiReq.website)
+		if apiErr := parseApiPreview(request, apiReq); apiErr != nil {
+			return nil, apiErr
+		}
+		return apiReq, nil
+	}
+
+	if !originOk {
+		return nil, &apiError{status: 400, message: "Invalid domain"}
+	}
+
+	var err error
+	apiReq.website, err = findWebsiteByDomain(origin)
 	if err != nil {
-		response.Error = "Unknown domain"
-		kit.JSON(400, response)
-		return nil
+		if apiErr := limitApiRequest(kit, nil); apiErr != nil {
+			return nil, apiErr
+		}
+		return nil, &apiError{status: 400, message: "Unknown domain"}
+	}
+	if apiErr := limitApiRequest(kit, apiReq.website); apiErr != nil {
+		return nil, apiErr
+	}
+	apiReq.languages = languageChain(apiReq.preferredLanguages, apiReq.website.FallbackLanguage)
+	restrictApiCors(kit.Response, request, apiReq.website)
+	if apiErr := parseApiPreview(request, apiReq); apiErr != nil {
+		return nil, apiErr
 	}
 
+	return apiReq, nil
+}
+
+// languageChain appends the fallback language of a website to the preferred
+// languages of a request. The result is never empty.
+func languageChain(preferred []string, fallback string) []string {
+	languages := preferred
+	if fallback != "" && IsValidLanguage(fallback) && !slices.Contains(languages, fallback) {
+		languages = append(languages, fallback)
+	}
+	if len(languages) == 0 {
+		languages = append(languages, defaultApiLanguage)
+	}
+	return languages
+}
+
+// renderApiMessages converts messages to their API representation, using
+// their translation in the given language and their slot on the website,
+// along with their page targeting on the website. The HTML is sanitised with
+// the policy of the website, and its links go through the redirect endpoint
+// when trackLinks is set and the website tracks them.
+func renderApiMessages(website *models.Website, dbMessageList []*models.Message, links map[int64]*models.WebsitesMessage, lang string, trackLinks bool) ([]Message, []*helpers.PathTargeting) {
+	sanitizer := helpers.NewSanitizer(getWebsiteSanitizePolicy(website))
+	messages := make([]Message, 0, len(dbMessageList))
+	targets := make([]*helpers.PathTargeting, 0, len(dbMessageList))
+	for _, dbMessage := range dbMessageList {
+		translation := findTranslation(dbMessage.R.MessageTranslations, lang)
+		if translation == nil {
+			continue
+		}
+
+		targeting := &helpers.PathTargeting{}
+		link, ok := links[dbMessage.ID]
+		if ok {
+			targeting = helpers.NewPathTargeting(link.IncludePaths, link.ExcludePaths)
+		}
+
+		var rewriteLink func(string) string
+		if trackLinks {
+			rewriteLink = trackedLinkRewriter(website, dbMessage.ID)
+		}
+
+		message := Message{
+			ID:          dbMessage.ID,
+			Revision:    dbMessage.Revision,
+			Title:       translation.Title,
+			Message:     string(sanitizer.SanitizeBytes(renderMarkdown([]byte(translation.Content), rewriteLink))),
+			Type:        dbMessage.Type,
+			Slot:        getMessageSlot(link),
+			Language:    lang,
+			DisplayFrom: dbMessage.DisplayFrom.UTC(),
+			DisplayTo:   dbMessage.DisplayTo.UTC(),
+		}
+		messages = append(messages, message)
+		targets = append(targets, targeting)
+	}
+	return messages, targets
+}
+
+// loadActiveMessages returns the messages of a website to display at the given
+// time in the given language, with their translation in that language, along
+// with their links to the website, by message id, and the next instant at
+// which any message targeting the website starts or stops being displayed.
+func loadActiveMessages(ctx context.Context, website *models.Website, lang string, now time.Time) ([]*models.Message, map[int64]*models.WebsitesMessage, time.Time, error) {
 	messagesIds, err := models.WebsitesMessages(
-		models.WebsitesMessageWhere.WebsiteId.EQ(dbWebsite.ID),
-	).All(kit.Request.Context(), db.Query)
+		models.WebsitesMessageWhere.WebsiteId.EQ(website.ID),
+	).All(ctx, db.Query)
 	if err != nil {
-		kit.JSON(200, response)
-		return nil
+		return nil, nil, time.Time{}, err
 	}
 
+	links := make(map[int64]*models.WebsitesMessage, len(messagesIds))
 	messagesIdsList := make([]int64, 0, len(messagesIds))
 	for _, message := range messagesIds {
 		messagesIdsList = append(messagesIdsList, message.MessageId)
+		links[message.MessageId] = message
 	}
 
-	mod := []qm.QueryMod{
+	dbMessageList, err := models.Messages(
 		models.MessageWhere.ID.IN(messagesIdsList),
-		models.MessageWhere.Language.EQ(lang),
-		models.MessageWhere.DisplayTo.GT(time.Now().In(loc)),
-	}
-
-	if !dbWebsite.Staging {
-		mod = append(mod, models.MessageWhere.DisplayFrom.LT(time.Now().In(loc)))
+		models.MessageWhere.DisplayTo.GT(now.UTC()),
+		qm.Load(models.MessageRels.MessageTranslations, models.MessageTranslationWhere.Language.EQ(lang)),
+		qm.OrderBy("id ASC"),
+	).All(ctx, db.Query)
+	if err != nil {
+		return nil, nil, time.Time{}, err
 	}
 
-	dbMessageList, err := models.Messages(mod...).All(kit.Request.Context(), db.Query)
-
-	response.Messages = make([]Message, 0, len(dbMessageList))
+	var nextBoundary time.Time
+	activeMessages := make([]*models.Message, 0, len(dbMessageList))
 	for _, dbMessage := range dbMessageList {
-		message := Message{
-			Title:   dbMessage.Title,
-			Message: string(mdToHTML([]byte(dbMessage.Message))),
-			Type:    dbMessage.Type,
-		}
-		if dbWebsite.Staging && dbMessage.DisplayFrom.After(time.Now().In(loc)) {
-			message.Message = "[Preview] " + message.Message
+		messageSchedule := getMessageSchedule(dbMessage, website)
+		boundary := messageSchedule.NextBoundary(now)
+		if !boundary.IsZero() && (nextBoundary.IsZero() || boundary.Before(nextBoundary)) {
+			nextBoundary = boundary
 		}
 
-		response.Messages = append(response.Messages, message)
+		if findTranslation(dbMessage.R.MessageTranslations, lang) == nil {
+			continue
+		}
+		window, active := messageSchedule.Active(now)
+		if !active {
+			continue
+		}
+		// Recurring messages and messages with a daily window are delivered
+		// with the period they are currently displayed for as display period.
+		dbMessage.DisplayFrom, dbMessage.DisplayTo = window.Start, window.End
+		activeMessages = append(activeMessages, dbMessage)
 	}
-	kit.JSON(200, response)
-	return nil
+
+	return activeMessages, links, nextBoundary, nil
 }
 
-func IsValidLanguage(lang string) bool {
-	return lang == "en" || lang == "fr"
+// getWebsiteSanitizePolicy returns the restrictions applied to the HTML
+// delivered to a website.
+func getWebsiteSanitizePolicy(website *models.Website) helpers.SanitizePolicy {
+	schemes, _ := helpers.ParseURLSchemes(website.AllowedURLSchemes)
+	return helpers.SanitizePolicy{
+		ForbidLinks:  website.ForbidLinks,
+		ForbidImages: website.ForbidImages,
+		URLSchemes:   schemes,
+	}
 }
 
 func mdToHTML(md []byte) []byte {
+	return renderMarkdown(md, nil)
+}
+
+// renderMarkdown converts markdown to HTML. rewriteLink, when not nil,
+// replaces the destination of every link.
+func renderMarkdown(md []byte, rewriteLink func(string) string) []byte {
 	// create markdown parser with extensions
 	extensions := parser.CommonExtensions | parser.AutoHeadingIDs | parser.NoEmptyLineBeforeBlock
 	p := parser.NewWithExtensions(extensions)
 	doc := p.Parse(md)
 
+	if rewriteLink != nil {
+		ast.WalkFunc(doc, func(node ast.Node, entering bool) ast.WalkStatus {
+			// Footnote references are links within the message.
+			if link, ok := node.(*ast.Link); ok && entering && link.NoteID == 0 {
+				link.Destination = []byte(rewriteLink(string(link.Destination)))
+			}
+			return ast.GoToNext
+		})
+	}
+
 	// create HTML renderer with extensions
 	htmlFlags := html.CommonFlags | html.HrefTargetBlank
 	opts := html.RendererOptions{Flags: htmlFlags}
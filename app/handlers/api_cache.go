@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"context"
+	"messages/app/db"
+	"messages/app/helpers"
+	"messages/app/models"
+	"sync"
+	"time"
+)
+
+// messagesCacheMaxTTL bounds the lifetime of entries without an upcoming
+// schedule boundary, so changes made outside of the admin UI are picked up.
+const messagesCacheMaxTTL = 5 * time.Minute
+
+// messagesCacheSweepInterval is how often the expired entries are dropped.
+const messagesCacheSweepInterval = time.Minute
+
+type messagesCacheKey struct {
+	websiteId int64
+	lang      string
+}
+
+// messagesCacheEntry holds the rendered messages of a website in a language.
+type messagesCacheEntry struct {
+	messages []Message
+	// targets holds the page targeting of each of the messages.
+	targets      []*helpers.PathTargeting
+	fingerprint  string
+	nextBoundary time.Time
+	expiresAt    time.Time
+}
+
+// messagesCache is an in-process cache of the payloads served by HandleApi.
+// Writes to messages and websites invalidate the affected entries, and every
+// entry expires at the next schedule boundary of its website.
+type messagesCache struct {
+	mu         sync.RWMutex
+	entries    map[messagesCacheKey]*messagesCacheEntry
+	generation uint64
+	lastSweep  time.Time
+}
+
+var apiMessagesCache = &messagesCache{
+	entries: make(map[messagesCacheKey]*messagesCacheEntry),
+}
+
+func (c *messagesCache) get(key messagesCacheKey, now time.Time) (*messagesCacheEntry, uint64, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !now.Before(entry.expiresAt) {
+		return nil, c.generation, false
+	}
+	return entry, c.generation, true
+}
+
+// set stores an entry unless the cache was invalidated since generation was
+// read, in which case the entry may have been built from stale rows.
+func (c *messagesCache) set(key messagesCacheKey, entry *messagesCacheEntry, generation uint64, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+	c.sweep(now)
+	c.entries[key] = entry
+}
+
+// sweep drops the expired entries, such as the ones of the languages no
+// longer requested. It runs at most once per messagesCacheSweepInterval.
+func (c *messagesCache) sweep(now time.Time) {
+	if now.Sub(c.lastSweep) < messagesCacheSweepInterval {
+		return
+	}
+	c.lastSweep = now
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *messagesCache) invalidateWebsites(websiteIds ...int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for key := range c.entries {
+		for _, websiteId := range websiteIds {
+			if key.websiteId == websiteId {
+				delete(c.entries, key)
+				break
+			}
+		}
+	}
+}
+
+// messagesForPath returns the messages of the entry targeting a page path,
+// which is empty when unknown.
+func (e *messagesCacheEntry) messagesForPath(path string) []Message {
+	messages := make([]Message, 0, len(e.messages))
+	for i, message := range e.messages {
+		if e.targets[i].Matches(path) {
+			messages = append(messages, message)
+		}
+	}
+	return messages
+}
+
+// getActiveMessagesEntry returns the rendered messages of a website in a
+// language at the given time, from the cache when possible.
+func getActiveMessagesEntry(ctx context.Context, website *models.Website, lang string, now time.Time) (*messagesCacheEntry, error) {
+	key := messagesCacheKey{websiteId: website.ID, lang: lang}
+	entry, generation, ok := apiMessagesCache.get(key, now)
+	if ok {
+		return entry, nil
+	}
+
+	entry, err := buildMessagesEntry(ctx, website, lang, true, now)
+	if err != nil {
+		return nil, err
+	}
+
+	apiMessagesCache.set(key, entry, generation, now)
+	return entry, nil
+}
+
+// buildMessagesEntry renders the messages of a website in a language at the
+// given time, with tracked links when trackLinks is set.
+func buildMessagesEntry(ctx context.Context, website *models.Website, lang string, trackLinks bool, now time.Time) (*messagesCacheEntry, error) {
+	dbMessageList, links, nextBoundary, err := loadActiveMessages(ctx, website, lang, now)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, targets := renderApiMessages(website, dbMessageList, links, lang, trackLinks)
+	entry := &messagesCacheEntry{
+		messages:     messages,
+		targets:      targets,
+		fingerprint:  messagesFingerprint(website, lang, dbMessageList),
+		nextBoundary: nextBoundary,
+		expiresAt:    now.Add(messagesCacheMaxTTL),
+	}
+	if !nextBoundary.IsZero() && nextBoundary.Before(entry.expiresAt) {
+		entry.expiresAt = nextBoundary
+	}
+	return entry, nil
+}
+
+// invalidateApiMessagesCache drops the cached messages of the given websites
+// and notifies their open streams.
+func invalidateApiMessagesCache(websiteIds ...int64) {
+	apiMessagesCache.invalidateWebsites(websiteIds...)
+	apiMessagesStreams.notify(websiteIds...)
+}
+
+// getMessageWebsiteIds returns the ids of the websites a message targets.
+func getMessageWebsiteIds(ctx context.Context, messageId int64) ([]int64, error) {
+	dbWebsitesMessages, err := models.WebsitesMessages(
+		models.WebsitesMessageWhere.MessageId.EQ(messageId),
+	).All(ctx, db.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	websiteIds := make([]int64, 0, len(dbWebsitesMessages))
+	for _, websiteMessage := range dbWebsitesMessages {
+		websiteIds = append(websiteIds, websiteMessage.WebsiteId)
+	}
+	return websiteIds, nil
+}
@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"messages/app/db"
+	"messages/app/helpers"
+	"messages/app/models"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/anthdm/superkit/kit"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// corsAllowedHeaders lists the request headers browsers may send to the API.
+const corsAllowedHeaders = "Accept-Language, Content-Type, Timezone, X-Api-Key, X-Preview-Token, If-None-Match, Last-Event-ID"
+
+// corsExposedHeaders lists the response headers readable by browsers, besides
+// the CORS-safelisted ones.
+const corsExposedHeaders = "Content-Language, ETag"
+
+// websiteCorsPolicy holds a website along with the origins allowed to call
+// the API for it.
+type websiteCorsPolicy struct {
+	website *models.Website
+	domains []*helpers.DomainPattern
+	// origins are the extra origins of the website, normalized.
+	origins []string
+	maxAge  int
+}
+
+// allows reports whether an origin may read the API responses of the website.
+func (p *websiteCorsPolicy) allows(origin string) bool {
+	if normalized, ok := helpers.NormalizeOrigin(origin); ok && slices.Contains(p.origins, normalized) {
+		return true
+	}
+
+	parsedOrigin, ok := helpers.ParseOrigin(origin)
+	return ok && p.matchDomain(parsedOrigin) != nil
+}
+
+// matchDomain returns the most specific domain pattern of the website matching
+// an origin, if any. Development patterns only apply to staging websites.
+func (p *websiteCorsPolicy) matchDomain(origin *helpers.Origin) *helpers.DomainPattern {
+	var match *helpers.DomainPattern
+	for _, pattern := range p.domains {
+		if pattern.IsDevelopment() && !p.website.Staging {
+			continue
+		}
+		if pattern.Match(origin) && (match == nil || pattern.Specificity() > match.Specificity()) {
+			match = pattern
+		}
+	}
+	return match
+}
+
+// corsCatalogue caches the websites with their domains and CORS policies,
+// which are checked by every API request. Writes to websites through the admin
+// UI reload it.
+type corsCatalogue struct {
+	mu       sync.RWMutex
+	policies []*websiteCorsPolicy
+	loaded   bool
+}
+
+var corsPolicyList = &corsCatalogue{}
+
+// all returns the CORS policies of every website.
+func (c *corsCatalogue) all() []*websiteCorsPolicy {
+	c.mu.RLock()
+	if c.loaded {
+		defer c.mu.RUnlock()
+		return c.policies
+	}
+	c.mu.RUnlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.loaded {
+		return c.policies
+	}
+
+	dbWebsites, err := models.Websites(
+		qm.Load(models.WebsiteRels.WebsiteDomains),
+	).All(context.Background(), db.Query)
+	if err != nil {
+		// Not marked as loaded, so the next call tries again.
+		slog.Error("failed to load CORS policies", "err", err.Error())
+		return nil
+	}
+
+	policies := make([]*websiteCorsPolicy, 0, len(dbWebsites))
+	for _, dbWebsite := range dbWebsites {
+		policies = append(policies, getWebsiteCorsPolicy(dbWebsite))
+	}
+
+	c.policies = policies
+	c.loaded = true
+	return c.policies
+}
+
+func (c *corsCatalogue) find(websiteId int64) *websiteCorsPolicy {
+	for _, policy := range c.all() {
+		if policy.website.ID == websiteId {
+			return policy
+		}
+	}
+	return nil
+}
+
+func (c *corsCatalogue) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.policies = nil
+	c.loaded = false
+}
+
+// getWebsiteCorsPolicy returns the origins allowed for a website: its domains
+// plus its extra origins. The domains of the website must be loaded.
+func getWebsiteCorsPolicy(website *models.Website) *websiteCorsPolicy {
+	policy := &websiteCorsPolicy{
+		website: website,
+		domains: make([]*helpers.DomainPattern, 0, len(website.R.WebsiteDomains)+1),
+		origins: parseCorsOrigins(website.CorsOrigins),
+		maxAge:  int(website.CorsMaxAge),
+	}
+	if pattern, ok := helpers.ParseDomainPattern(website.URL); ok {
+		policy.domains = append(policy.domains, pattern)
+	}
+	for _, dbDomain := range website.R.WebsiteDomains {
+		if pattern, ok := helpers.ParseDomainPattern(dbDomain.Pattern); ok {
+			policy.domains = append(policy.domains, pattern)
+		}
+	}
+	if policy.maxAge <= 0 {
+		policy.maxAge = getDefaultCorsMaxAge()
+	}
+	return policy
+}
+
+// getDefaultCorsMaxAge returns the lifetime in seconds of the preflight
+// responses of websites without their own.
+func getDefaultCorsMaxAge() int {
+	maxAge, err := strconv.Atoi(kit.Getenv("API_CORS_MAX_AGE", "600"))
+	if err != nil || maxAge < 0 {
+		return 0
+	}
+	return maxAge
+}
+
+// parseCorsOrigins splits a list of origins separated by commas or new lines,
+// skipping the invalid ones.
+func parseCorsOrigins(list string) []string {
+	origins := make([]string, 0)
+	for _, origin := range strings.FieldsFunc(list, isListSeparator) {
+		if normalized, ok := helpers.NormalizeOrigin(origin); ok {
+			origins = append(origins, normalized)
+		}
+	}
+	return origins
+}
+
+// WithApiCors answers the preflight requests of the public API and allows the
+// origins registered by any website to read its responses. Once the website
+// of a request is known, restrictApiCors narrows this down to its own origins.
+func WithApiCors(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Origin")
+
+		origin := r.Header.Get("Origin")
+		var policies []*websiteCorsPolicy
+		if origin != "" {
+			for _, policy := range corsPolicyList.all() {
+				if policy.allows(origin) {
+					policies = append(policies, policy)
+				}
+			}
+		}
+
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+		if len(policies) == 0 {
+			if preflight {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		if !preflight {
+			w.Header().Set("Access-Control-Expose-Headers", corsExposedHeaders)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// The website is not known before the actual request, so the shortest
+		// lifetime of the matching websites is used.
+		maxAge := policies[0].maxAge
+		for _, policy := range policies[1:] {
+			maxAge = min(maxAge, policy.maxAge)
+		}
+		w.Header().Add("Vary", "Access-Control-Request-Method, Access-Control-Request-Headers")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", corsAllowedHeaders)
+		w.Header().Set("Access-Control-Max-Age", strconv.Itoa(maxAge))
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleApiPreflight answers the OPTIONS requests of the public API which are
+// not preflight requests, WithApiCors having answered the others.
+func HandleApiPreflight(kit *kit.Kit) error {
+	kit.Response.Header().Set("Allow", "GET, POST, OPTIONS")
+	kit.Response.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// restrictApiCors withdraws the CORS headers set by WithApiCors when the
+// origin of a request is not allowed by the website it addresses.
+func restrictApiCors(w http.ResponseWriter, r *http.Request, website *models.Website) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return
+	}
+	if policy := corsPolicyList.find(website.ID); policy != nil && policy.allows(origin) {
+		return
+	}
+	w.Header().Del("Access-Control-Allow-Origin")
+	w.Header().Del("Access-Control-Expose-Headers")
+}
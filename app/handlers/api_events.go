@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	v1 "messages/app/api/v1"
+	"messages/app/db"
+	"messages/app/models"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/anthdm/superkit/kit"
+)
+
+const (
+	// apiEventsMaxBody bounds the size of a beacon.
+	apiEventsMaxBody = 16 << 10
+	// apiEventsMaxCount bounds the number of events of a beacon.
+	apiEventsMaxCount = 50
+	// apiEventsFlushInterval is how often the counted events are written to
+	// the database.
+	apiEventsFlushInterval = time.Minute
+)
+
+// messageEventTypes lists the events recorded by the beacon endpoint.
+var messageEventTypes = []string{"impression", "click", "dismissal"}
+
+// messageEvent is an event reported by a client about a message it displays.
+type messageEvent = v1.Event
+
+// messageStatsKey identifies an hourly bucket of the statistics of a message
+// on a website in a language.
+type messageStatsKey struct {
+	messageId int64
+	websiteId int64
+	language  string
+	hour      time.Time
+}
+
+type messageStatsCounts struct {
+	impressions int64
+	clicks      int64
+	dismissals  int64
+}
+
+// linkClicksKey identifies an hourly bucket of the clicks on a link of a
+// message on a website.
+type linkClicksKey struct {
+	messageId int64
+	websiteId int64
+	url       string
+	hour      time.Time
+}
+
+// messageStatsCollector counts the events reported by clients and the clicks
+// on tracked links in memory, so a busy website results in a single write per
+// bucket and flush.
+type messageStatsCollector struct {
+	mu         sync.Mutex
+	counts     map[messageStatsKey]*messageStatsCounts
+	linkClicks map[linkClicksKey]int64
+	flushOnce  sync.Once
+}
+
+var apiMessageStats = &messageStatsCollector{
+	counts:     make(map[messageStatsKey]*messageStatsCounts),
+	linkClicks: make(map[linkClicksKey]int64),
+}
+
+// add counts an event in the bucket of the hour it occurred.
+func (c *messageStatsCollector) add(websiteId int64, event messageEvent, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := messageStatsKey{
+		messageId: event.MessageId,
+		websiteId: websiteId,
+		language:  event.Language,
+		hour:      now.UTC().Truncate(time.Hour),
+	}
+	counts, ok := c.counts[key]
+	if !ok {
+		counts = &messageStatsCounts{}
+		c.counts[key] = counts
+	}
+	switch event.Type {
+	case "impression":
+		counts.impressions++
+	case "click":
+		counts.clicks++
+	case "dismissal":
+		counts.dismissals++
+	}
+
+	c.flushOnce.Do(func() { go c.run() })
+}
+
+// addLinkClick counts a click on a tracked link in the bucket of the hour it
+// occurred.
+func (c *messageStatsCollector) addLinkClick(messageId int64, websiteId int64, url string, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.linkClicks[linkClicksKey{
+		messageId: messageId,
+		websiteId: websiteId,
+		url:       url,
+		hour:      now.UTC().Truncate(time.Hour),
+	}]++
+
+	c.flushOnce.Do(func() { go c.run() })
+}
+
+// run periodically adds the counted events to the hourly buckets of the
+// database.
+func (c *messageStatsCollector) run() {
+	ticker := time.NewTicker(apiEventsFlushInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		c.flush()
+	}
+}
+
+// flush adds the events counted since the last flush to the hourly buckets of
+// the database.
+func (c *messageStatsCollector) flush() {
+	c.mu.Lock()
+	counts, linkClicks := c.counts, c.linkClicks
+	c.counts = make(map[messageStatsKey]*messageStatsCounts)
+	c.linkClicks = make(map[linkClicksKey]int64)
+	c.mu.Unlock()
+
+	for key, count := range counts {
+		if _, err := db.Query.ExecContext(context.Background(),
+			`INSERT INTO message_stats (message_id, website_id, language, hour, impressions, clicks, dismissals)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (message_id, website_id, language, hour) DO UPDATE SET
+				impressions = impressions + excluded.impressions,
+				clicks = clicks + excluded.clicks,
+				dismissals = dismissals + excluded.dismissals`,
+			key.messageId, key.websiteId, key.language, key.hour, count.impressions, count.clicks, count.dismissals,
+		); err != nil {
+			slog.Error("failed to save message stats", "message", key.messageId, "website", key.websiteId, "err", err.Error())
+		}
+	}
+
+	for key, clicks := range linkClicks {
+		if _, err := db.Query.ExecContext(context.Background(),
+			`INSERT INTO message_link_clicks (message_id, website_id, url, hour, clicks)
+			VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT (message_id, website_id, url, hour) DO UPDATE SET
+				clicks = clicks + excluded.clicks`,
+			key.messageId, key.websiteId, key.url, key.hour, clicks,
+		); err != nil {
+			slog.Error("failed to save link clicks", "message", key.messageId, "website", key.websiteId, "err", err.Error())
+		}
+	}
+}
+
+// FlushMessageStats writes the events counted in memory to the database. It
+// is called when the server shuts down, so they are not lost.
+func FlushMessageStats() {
+	apiMessageStats.flush()
+}
+
+// HandleApiEvents records the impressions, link clicks and dismissals of
+// messages reported by clients, typically with navigator.sendBeacon. Events
+// about messages the website does not display are ignored.
+func HandleApiEvents(kit *kit.Kit) error {
+	response := Response{
+		Origin:   kit.Request.Header.Get("Origin"),
+		Messages: make([]Message, 0),
+		Slots:    make(map[string][]Message),
+	}
+
+	apiReq, apiErr := parseApiRequest(kit)
+	if apiErr != nil {
+		response.Error = apiErr.message
+		return renderApiJSON(kit, apiErr.status, response)
+	}
+	response.Origin = apiReq.origin
+
+	// Beacons are sent as text/plain to avoid a preflight request, so the
+	// body is decoded whatever its content type.
+	var eventsReq v1.EventsRequest
+	body := http.MaxBytesReader(kit.Response, kit.Request.Body, apiEventsMaxBody)
+	if err := json.NewDecoder(body).Decode(&eventsReq); err != nil || len(eventsReq.Events) > apiEventsMaxCount {
+		response.Error = "Invalid events"
+		return renderApiJSON(kit, 400, response)
+	}
+
+	messageIds := make([]int64, 0, len(eventsReq.Events))
+	for _, event := range eventsReq.Events {
+		messageIds = append(messageIds, event.MessageId)
+	}
+	dbWebsitesMessages, err := models.WebsitesMessages(
+		models.WebsitesMessageWhere.WebsiteId.EQ(apiReq.website.ID),
+		models.WebsitesMessageWhere.MessageId.IN(messageIds),
+	).All(kit.Request.Context(), db.Query)
+	if err != nil {
+		slog.Error("failed to load the messages of the website", "website", apiReq.website.ID, "err", err.Error())
+		response.Error = "Failed to record events"
+		return renderApiJSON(kit, 500, response)
+	}
+	displayed := make(map[int64]bool, len(dbWebsitesMessages))
+	for _, websiteMessage := range dbWebsitesMessages {
+		displayed[websiteMessage.MessageId] = true
+	}
+
+	now := time.Now()
+	for _, event := range eventsReq.Events {
+		if !displayed[event.MessageId] || !slices.Contains(messageEventTypes, event.Type) || !IsValidLanguage(event.Language) {
+			continue
+		}
+		apiMessageStats.add(apiReq.website.ID, event, now)
+	}
+
+	kit.Response.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"fmt"
+	"html"
+	"messages/app/views/api"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/anthdm/superkit/kit"
+)
+
+// HandleApiFragment returns the active messages as an HTML fragment, for
+// websites including them server side without JavaScript.
+func HandleApiFragment(kit *kit.Kit) error {
+	return renderApiHtml(kit, api.MessagesFragment)
+}
+
+// HandleApiPage returns the active messages as a standalone page, for
+// websites displaying them in an iframe.
+func HandleApiPage(kit *kit.Kit) error {
+	return renderApiHtml(kit, api.MessagesPage)
+}
+
+func renderApiHtml(kit *kit.Kit, component func(*api.MessagesFragmentData) templ.Component) error {
+	request := kit.Request
+	kit.Response.Header().Set("Content-Type", "text/html; charset=utf-8")
+	kit.Response.Header().Set("Vary", apiVaryHeaders)
+
+	apiReq, apiErr := parseApiRequest(kit)
+	if apiErr != nil {
+		// Server-side includes usually paste the body whatever the status,
+		// so errors are only visible in the page source.
+		kit.Response.WriteHeader(apiErr.status)
+		fmt.Fprintf(kit.Response, "<!-- messages: %s -->", html.EscapeString(apiErr.message))
+		return nil
+	}
+
+	now := time.Now().UTC()
+	entry, messages, lang, err := getNegotiatedMessagesEntry(request.Context(), apiReq, now)
+	if err != nil {
+		return err
+	}
+	kit.Response.Header().Set("Content-Language", lang)
+
+	// The representation is part of the ETag, as the JSON, fragment and page
+	// responses of the same messages differ.
+	dir := getLanguageDirection(lang)
+	if apiReq.preview != nil {
+		setPreviewCacheHeaders(kit.Response)
+	} else {
+		etag := computeMessagesETag(request.URL.Path+"|"+dir+"|"+apiReq.variant(), entry.fingerprint)
+		setCacheHeaders(kit.Response, etag, now, entry.nextBoundary)
+		if etagMatches(request.Header.Get("If-None-Match"), etag) {
+			kit.Response.WriteHeader(304)
+			return nil
+		}
+	}
+
+	data := &api.MessagesFragmentData{
+		Lang:     lang,
+		Dir:      dir,
+		Messages: make([]api.FragmentMessage, 0, len(messages)),
+	}
+	for _, message := range messages {
+		data.Messages = append(data.Messages, api.FragmentMessage{
+			ID:       message.ID,
+			Revision: message.Revision,
+			Title:    message.Title,
+			Body:     message.Message,
+			Type:     message.Type,
+			Slot:     message.Slot,
+		})
+	}
+
+	return kit.Render(component(data))
+}
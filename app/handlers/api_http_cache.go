@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"messages/app/models"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anthdm/superkit/kit"
+)
+
+// apiVaryHeaders lists the request headers the API response depends on.
+const apiVaryHeaders = "Origin, Referer, Accept-Language, Timezone, X-Api-Key"
+
+// messagesFingerprint identifies a website, a language and a set of active
+// messages, along with the occurrence of the recurring ones.
+func messagesFingerprint(website *models.Website, lang string, messages []*models.Message) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%d|%s", website.ID, lang)
+	fmt.Fprintf(hash, "|%t|%t|%s|%t", website.ForbidLinks, website.ForbidImages, website.AllowedURLSchemes, website.LinkTracking)
+	for _, message := range messages {
+		fmt.Fprintf(hash, "|%d:%d:%d:%d", message.ID, message.Revision, message.UpdatedAt.UnixNano(), message.DisplayFrom.Unix())
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// computeMessagesETag returns a strong ETag for the response served to a
+// variant of the requests, such as an origin, for a messages fingerprint.
+func computeMessagesETag(variant string, fingerprint string) string {
+	hash := sha256.Sum256([]byte(variant + "|" + fingerprint))
+	return `"` + hex.EncodeToString(hash[:])[:32] + `"`
+}
+
+// etagMatches reports whether an If-None-Match header matches the given ETag,
+// using the weak comparison required for GET requests.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setCacheHeaders sets the ETag and caching headers of an API response. The
+// cache lifetime never extends past the next schedule boundary, so a cached
+// response cannot outlive the message set it contains.
+func setCacheHeaders(w http.ResponseWriter, etag string, now time.Time, nextBoundary time.Time) {
+	maxAge, err := strconv.Atoi(kit.Getenv("API_CACHE_MAX_AGE", "60"))
+	if err != nil || maxAge < 0 {
+		maxAge = 0
+	}
+
+	if !nextBoundary.IsZero() {
+		untilBoundary := int(nextBoundary.Sub(now) / time.Second)
+		if untilBoundary < maxAge {
+			maxAge = untilBoundary
+		}
+	}
+
+	w.Header().Set("ETag", etag)
+	if maxAge > 0 {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", maxAge))
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+}
+
+// setPreviewCacheHeaders keeps previews, which may show unpublished messages,
+// out of every cache.
+func setPreviewCacheHeaders(w http.ResponseWriter) {
+	w.Header().Set("Cache-Control", "no-store")
+}
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"messages/app/db"
+	"messages/app/helpers"
+	"messages/app/models"
+	"messages/app/views/websites"
+	"messages/plugins/auth"
+	"net/http"
+	"strconv"
+	"time"
+
+	v "github.com/anthdm/superkit/validate"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/anthdm/superkit/kit"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// apiKeyUsageResolution avoids writing last_used_at on every API call.
+const apiKeyUsageResolution = time.Minute
+
+func findWebsiteByApiKey(ctx context.Context, apiKey string) (*models.Website, error) {
+	dbApiKey, err := models.WebsiteAPIKeys(
+		models.WebsiteAPIKeyWhere.KeyHash.EQ(helpers.HashAPIKey(apiKey)),
+		models.WebsiteAPIKeyWhere.RevokedAt.IsNull(),
+		qm.Load(models.WebsiteAPIKeyRels.Website),
+	).One(ctx, db.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbApiKey.R.Website == nil {
+		return nil, errors.New("website not found")
+	}
+
+	now := time.Now().UTC()
+	if !dbApiKey.LastUsedAt.Valid || now.Sub(dbApiKey.LastUsedAt.Time) > apiKeyUsageResolution {
+		_, err = models.WebsiteAPIKeys(
+			models.WebsiteAPIKeyWhere.ID.EQ(dbApiKey.ID),
+		).UpdateAll(ctx, db.Query, models.M{
+			models.WebsiteAPIKeyColumns.LastUsedAt: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return dbApiKey.R.Website, nil
+}
+
+var createApiKeySchema = v.Schema{
+	"name": v.Rules(v.Max(100)),
+}
+
+func HandleWebsiteApiKeyCreate(kit *kit.Kit) error {
+	websiteId, err := helpers.GetIdFromUrl(kit)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	if _, err := models.FindWebsite(kit.Request.Context(), db.Query, websiteId); err != nil {
+		return renderApiKeysWebsiteError(kit, err)
+	}
+
+	formValues := &websites.ApiKeyFormValues{}
+	errors := v.Errors{}
+
+	if err := verifyAdminRole(kit.Auth().(auth.Auth)); err != nil {
+		errors.Add("form", "You are not allowed to create API keys")
+		return renderApiKeysSection(kit, websiteId, "", errors)
+	}
+
+	if errors, ok := v.Request(kit.Request, formValues, createApiKeySchema); !ok {
+		return renderApiKeysSection(kit, websiteId, "", errors)
+	}
+
+	key, prefix, hash, err := helpers.GenerateAPIKey()
+	if err != nil {
+		errors.Add("form", "Failed to generate API key")
+		return renderApiKeysSection(kit, websiteId, "", errors)
+	}
+
+	dbApiKey := &models.WebsiteAPIKey{
+		WebsiteID: websiteId,
+		Name:      formValues.Name,
+		KeyPrefix: prefix,
+		KeyHash:   hash,
+	}
+
+	if err := dbApiKey.Insert(kit.Request.Context(), db.Query, boil.Infer()); err != nil {
+		errors.Add("form", "Failed to create API key")
+		return renderApiKeysSection(kit, websiteId, "", errors)
+	}
+
+	return renderApiKeysSection(kit, websiteId, key, errors)
+}
+
+func HandleWebsiteApiKeyRevoke(kit *kit.Kit) error {
+	websiteId, err := helpers.GetIdFromUrl(kit)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	if _, err := models.FindWebsite(kit.Request.Context(), db.Query, websiteId); err != nil {
+		return renderApiKeysWebsiteError(kit, err)
+	}
+
+	errors := v.Errors{}
+
+	if err := verifyAdminRole(kit.Auth().(auth.Auth)); err != nil {
+		errors.Add("form", "You are not allowed to revoke API keys")
+		return renderApiKeysSection(kit, websiteId, "", errors)
+	}
+
+	keyId, err := strconv.ParseInt(chi.URLParam(kit.Request, "keyId"), 10, 64)
+	if err != nil {
+		errors.Add("form", "Invalid API key ID")
+		return renderApiKeysSection(kit, websiteId, "", errors)
+	}
+
+	if _, err := models.WebsiteAPIKeys(
+		models.WebsiteAPIKeyWhere.ID.EQ(keyId),
+		models.WebsiteAPIKeyWhere.WebsiteID.EQ(websiteId),
+		models.WebsiteAPIKeyWhere.RevokedAt.IsNull(),
+	).UpdateAll(kit.Request.Context(), db.Query, models.M{
+		models.WebsiteAPIKeyColumns.RevokedAt: time.Now().UTC(),
+	}); err != nil {
+		errors.Add("form", "Failed to revoke API key")
+		return renderApiKeysSection(kit, websiteId, "", errors)
+	}
+
+	return renderApiKeysSection(kit, websiteId, "", errors)
+}
+
+// renderApiKeysWebsiteError answers a request for the API keys of a website
+// that could not be loaded, with a 404 when it does not exist.
+func renderApiKeysWebsiteError(kit *kit.Kit, err error) error {
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	kit.Response.WriteHeader(http.StatusNotFound)
+	return helpers.RenderNoticeError(kit, errors.New("Website not found"))
+}
+
+func renderApiKeysSection(kit *kit.Kit, websiteId int64, revealedKey string, errors v.Errors) error {
+	data, err := getApiKeysSectionData(kit.Request.Context(), websiteId, getUserLocation(kit))
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+	data.RevealedKey = revealedKey
+	data.FormErrors = errors
+
+	return kit.Render(websites.ApiKeysSection(data))
+}
+
+// getApiKeysSectionData lists the API keys of a website, with their dates in
+// loc.
+func getApiKeysSectionData(ctx context.Context, websiteId int64, loc *time.Location) (*websites.ApiKeysSectionData, error) {
+	dbApiKeys, err := models.WebsiteAPIKeys(
+		models.WebsiteAPIKeyWhere.WebsiteID.EQ(websiteId),
+		qm.OrderBy("created_at DESC"),
+	).All(ctx, db.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]*websites.ApiKeyListItem, 0, len(dbApiKeys))
+	for _, dbApiKey := range dbApiKeys {
+		apiKeys = append(apiKeys, &websites.ApiKeyListItem{
+			ID:         dbApiKey.ID,
+			Name:       dbApiKey.Name,
+			Prefix:     dbApiKey.KeyPrefix,
+			CreatedAt:  dbApiKey.CreatedAt.In(loc),
+			LastUsedAt: nullTimeToTime(dbApiKey.LastUsedAt).In(loc),
+			RevokedAt:  nullTimeToTime(dbApiKey.RevokedAt).In(loc),
+		})
+	}
+
+	return &websites.ApiKeysSectionData{
+		WebsiteID:  websiteId,
+		ApiKeys:    apiKeys,
+		FormValues: &websites.ApiKeyFormValues{},
+		FormErrors: v.Errors{},
+	}, nil
+}
+
+func nullTimeToTime(t null.Time) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
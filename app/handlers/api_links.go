@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"messages/app/helpers"
+	"messages/app/links"
+	"messages/app/models"
+	"time"
+
+	"github.com/anthdm/superkit/kit"
+)
+
+// getSigningSecret returns the secret signing the tracked links and the
+// preview tokens. Without one, links are not tracked and preview tokens cannot
+// be created.
+func getSigningSecret() string {
+	return kit.Getenv("SUPERKIT_SECRET", "")
+}
+
+// trackedLinkRewriter returns a function replacing the http and https links of
+// a message by links through the redirect endpoint, or nil when the links of
+// the website are not tracked. Tracked links are only built from the APP_URL
+// variable, never from the Host header of a request.
+func trackedLinkRewriter(website *models.Website, messageId int64) func(string) string {
+	if !website.LinkTracking {
+		return nil
+	}
+	return links.Rewriter(helpers.AppURL(), getSigningSecret(), messageId, website.ID)
+}
+
+// isLinkTracked reports whether a website still tracks the clicks on its
+// links.
+func isLinkTracked(websiteId int64) bool {
+	policy := corsPolicyList.find(websiteId)
+	return policy != nil && policy.website.LinkTracking
+}
+
+// HandleApiLink records a click on a tracked link and redirects to its
+// destination. Links whose signature does not match are refused, so the
+// endpoint cannot be used as an open redirect.
+func HandleApiLink(kit *kit.Kit) error {
+	links.Redirect(kit.Response, kit.Request, getSigningSecret(), isLinkTracked, func(link *links.Link) {
+		apiMessageStats.addLinkClick(link.MessageId, link.WebsiteId, link.Destination, time.Now())
+	})
+	return nil
+}
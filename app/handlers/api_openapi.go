@@ -0,0 +1,13 @@
+package handlers
+
+import (
+	v1 "messages/app/api/v1"
+
+	"github.com/anthdm/superkit/kit"
+)
+
+// HandleApiOpenAPI serves the OpenAPI document of version 1 of the public API.
+func HandleApiOpenAPI(kit *kit.Kit) error {
+	kit.Response.Header().Set("Cache-Control", "public, max-age=3600")
+	return kit.JSON(200, v1.Document())
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"messages/app/db"
+	"messages/app/helpers"
+	"messages/app/models"
+	"messages/app/ratelimit"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/anthdm/superkit/kit"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// apiRateLimitIdle is the inactivity after which the bucket of a client
+	// is dropped. It is refilled by then with the default limits.
+	apiRateLimitIdle = 10 * time.Minute
+	// apiRejectedFlushInterval is how often the rejected requests counters
+	// are written to the database.
+	apiRejectedFlushInterval = time.Minute
+)
+
+// apiRateLimits holds the token buckets of the clients of the API. A client
+// is limited by its IP address until its credential resolves to a website,
+// with the default limits, and by its IP address on that website afterwards,
+// with the limits of the website.
+var apiRateLimits = ratelimit.New(apiRateLimitIdle)
+
+// apiRejectedFlush starts flushRejected on the first rejected request.
+var apiRejectedFlush sync.Once
+
+// flushRejected periodically adds the rejected requests counted in memory to
+// the counters of the websites, so a flood of requests results in a single
+// write per website.
+func flushRejected() {
+	ticker := time.NewTicker(apiRejectedFlushInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		for websiteId, count := range apiRateLimits.TakeRejected() {
+			if _, err := db.Query.ExecContext(context.Background(),
+				"UPDATE websites SET rejected_requests = rejected_requests + ? WHERE id = ?",
+				count, websiteId,
+			); err != nil {
+				slog.Error("failed to save rejected requests", "website", websiteId, "err", err.Error())
+			}
+		}
+	}
+}
+
+// getApiRateLimit returns the sustained rate and the burst allowed to each
+// client of a website, from its own limits or else from the API_RATE_LIMIT
+// (requests per minute) and API_RATE_BURST variables. A zero rate disables
+// the limit. Without a website, the default limits are returned.
+func getApiRateLimit(website *models.Website) ratelimit.Limits {
+	var perMinute, burst int
+	if website != nil {
+		perMinute, burst = int(website.RateLimit), int(website.RateBurst)
+	}
+	if perMinute <= 0 {
+		perMinute = getEnvInt("API_RATE_LIMIT", 60)
+	}
+	if burst <= 0 {
+		burst = getEnvInt("API_RATE_BURST", 20)
+	}
+	if perMinute <= 0 {
+		return ratelimit.Limits{Rate: rate.Inf}
+	}
+	return ratelimit.Limits{Rate: rate.Limit(float64(perMinute) / 60), Burst: max(burst, 1)}
+}
+
+func getEnvInt(name string, defaultValue int) int {
+	value, err := strconv.Atoi(kit.Getenv(name, strconv.Itoa(defaultValue)))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+// checkApiClient rejects the requests of a client whose IP address exhausted
+// its bucket with credentials resolving to no website, before they are looked
+// up.
+func checkApiClient(kit *kit.Kit) *apiError {
+	return rejectApiRequest(kit, apiRateLimits.Check(helpers.ClientIP(kit.Request), time.Now()))
+}
+
+// limitApiRequest consumes a token of the bucket of a request, on its website
+// or, when its credential resolves to none, on its IP address.
+func limitApiRequest(kit *kit.Kit, website *models.Website) *apiError {
+	ip := helpers.ClientIP(kit.Request)
+	if website == nil {
+		return rejectApiRequest(kit, apiRateLimits.TakeUnknown(ip, getApiRateLimit(nil), time.Now()))
+	}
+
+	retryAfter := apiRateLimits.Take(website.ID, ip, getApiRateLimit(website), time.Now())
+	if retryAfter > 0 {
+		apiRejectedFlush.Do(func() { go flushRejected() })
+	}
+	return rejectApiRequest(kit, retryAfter)
+}
+
+// rejectApiRequest answers a request with a 429 when retryAfter is positive.
+func rejectApiRequest(kit *kit.Kit, retryAfter time.Duration) *apiError {
+	if retryAfter <= 0 {
+		return nil
+	}
+
+	kit.Response.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	return &apiError{status: http.StatusTooManyRequests, message: "Too many requests"}
+}
@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"messages/app/helpers"
+	"messages/app/models"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anthdm/superkit/kit"
+)
+
+const (
+	// apiStreamHeartbeat is the interval between two keep-alive comments.
+	apiStreamHeartbeat = 20 * time.Second
+	// apiStreamRetry is the reconnection delay advertised to clients, in ms.
+	apiStreamRetry = 5000
+)
+
+// messagesStreamHub notifies the open streams of a website when its
+// messages change.
+type messagesStreamHub struct {
+	mu          sync.Mutex
+	subscribers map[int64]map[chan struct{}]struct{}
+}
+
+var apiMessagesStreams = &messagesStreamHub{
+	subscribers: make(map[int64]map[chan struct{}]struct{}),
+}
+
+func (h *messagesStreamHub) subscribe(websiteId int64) chan struct{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ch := make(chan struct{}, 1)
+	if _, ok := h.subscribers[websiteId]; !ok {
+		h.subscribers[websiteId] = make(map[chan struct{}]struct{})
+	}
+	h.subscribers[websiteId][ch] = struct{}{}
+	return ch
+}
+
+func (h *messagesStreamHub) unsubscribe(websiteId int64, ch chan struct{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.subscribers[websiteId], ch)
+	if len(h.subscribers[websiteId]) == 0 {
+		delete(h.subscribers, websiteId)
+	}
+}
+
+func (h *messagesStreamHub) notify(websiteIds ...int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, websiteId := range websiteIds {
+		for ch := range h.subscribers[websiteId] {
+			// A pending notification is enough, the stream reloads everything.
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// HandleApiStream keeps a Server-Sent Events connection open and pushes the
+// payload of HandleApi every time it changes.
+func HandleApiStream(kit *kit.Kit) error {
+	request := kit.Request
+	kit.Response.Header().Set("Vary", apiVaryHeaders)
+
+	response := Response{
+		Origin:   request.Header.Get("Origin"),
+		Messages: make([]Message, 0),
+		Slots:    make(map[string][]Message),
+	}
+
+	apiReq, apiErr := parseApiRequest(kit)
+	if apiErr != nil {
+		kit.Response.Header().Set("Content-Type", "application/json")
+		response.Error = apiErr.message
+		kit.JSON(apiErr.status, response)
+		return nil
+	}
+	response.Origin = apiReq.origin
+
+	flusher, ok := kit.Response.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("streaming is not supported by the response writer")
+	}
+
+	changes := apiMessagesStreams.subscribe(apiReq.website.ID)
+	defer apiMessagesStreams.unsubscribe(apiReq.website.ID, changes)
+
+	kit.Response.Header().Set("Content-Type", "text/event-stream")
+	kit.Response.Header().Set("Cache-Control", "no-cache")
+	kit.Response.Header().Set("Connection", "keep-alive")
+	kit.Response.Header().Set("X-Accel-Buffering", "no")
+	kit.Response.WriteHeader(http.StatusOK)
+	fmt.Fprintf(kit.Response, "retry: %d\n\n", apiStreamRetry)
+	flusher.Flush()
+
+	lastEventId := request.Header.Get("Last-Event-ID")
+	heartbeat := time.NewTicker(apiStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		now := time.Now().UTC()
+		entry, messages, lang, err := getNegotiatedMessagesEntry(request.Context(), apiReq, now)
+		if err != nil {
+			return err
+		}
+
+		eventId := strings.Trim(computeMessagesETag(apiReq.variant(), entry.fingerprint), `"`)
+		if eventId != lastEventId {
+			response.Language = lang
+			response.Messages = messages
+			response.Slots = groupMessagesBySlot(messages)
+			payload, err := json.Marshal(response)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(kit.Response, "id: %s\nevent: messages\ndata: %s\n\n", eventId, payload)
+			flusher.Flush()
+			lastEventId = eventId
+		}
+
+		if !waitForMessagesChange(kit, flusher, heartbeat, changes, entry.nextBoundary.Sub(now)) {
+			return nil
+		}
+		// The website may have been edited or deleted meanwhile. Clients
+		// reconnecting get the error of the API.
+		if !reloadStreamWebsite(request, apiReq) {
+			return nil
+		}
+	}
+}
+
+// reloadStreamWebsite looks the website of a stream up again. It returns false
+// when the credential of the stream no longer designates the same website, or
+// when the website no longer allows the origin of the stream.
+func reloadStreamWebsite(request *http.Request, apiReq *apiRequest) bool {
+	var website *models.Website
+	var err error
+	if apiReq.apiKey != "" {
+		website, err = findWebsiteByApiKey(request.Context(), apiReq.apiKey)
+	} else if origin, ok := helpers.ParseOrigin(apiReq.origin); ok {
+		website, err = findWebsiteByDomain(origin)
+	} else {
+		return false
+	}
+	if err != nil || website.ID != apiReq.website.ID {
+		return false
+	}
+
+	if origin := request.Header.Get("Origin"); origin != "" {
+		if policy := corsPolicyList.find(website.ID); policy == nil || !policy.allows(origin) {
+			return false
+		}
+	}
+
+	apiReq.website = website
+	apiReq.languages = languageChain(apiReq.preferredLanguages, website.FallbackLanguage)
+	return true
+}
+
+// waitForMessagesChange blocks until the messages of the stream may have
+// changed, sending heartbeats meanwhile. It returns false once the client is
+// gone. A non-positive delay means there is no upcoming schedule boundary.
+func waitForMessagesChange(kit *kit.Kit, flusher http.Flusher, heartbeat *time.Ticker, changes chan struct{}, untilBoundary time.Duration) bool {
+	var boundary <-chan time.Time
+	if untilBoundary > 0 {
+		timer := time.NewTimer(untilBoundary)
+		defer timer.Stop()
+		boundary = timer.C
+	}
+
+	for {
+		select {
+		case <-kit.Request.Context().Done():
+			return false
+		case <-changes:
+			return true
+		case <-boundary:
+			return true
+		case <-heartbeat.C:
+			fmt.Fprint(kit.Response, ": ping\n\n")
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"fmt"
+	v1 "messages/app/api/v1"
+	"messages/app/db"
+	"net/http"
+	"net/url"
+	"regexp"
+	"testing"
+)
+
+// decodeMessages returns the titles of the messages of an API response.
+func decodeMessages(t *testing.T, resp *http.Response) []string {
+	t.Helper()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got %d, want 200", resp.StatusCode)
+	}
+	var response v1.Response
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+	titles := make([]string, 0, len(response.Messages))
+	for _, message := range response.Messages {
+		titles = append(titles, message.Title)
+	}
+	return titles
+}
+
+func TestApiCacheInvalidatedOnMessageEdit(t *testing.T) {
+	website, key := createWebsite(t, "Invalidation", "invalidation.example.com")
+	messageId := createMessage(t, website, "Before the edit")
+	header := http.Header{"X-Api-Key": {key}}
+
+	if titles := decodeMessages(t, apiGet(t, "/api/v1/messages", header)); fmt.Sprint(titles) != "[Before the edit]" {
+		t.Fatalf("messages = %v, want the new message", titles)
+	}
+
+	submit(t, "PATCH", fmt.Sprintf("/message/%d", messageId), messageForm(website, "After the edit"))
+	if titles := decodeMessages(t, apiGet(t, "/api/v1/messages", header)); fmt.Sprint(titles) != "[After the edit]" {
+		t.Errorf("messages = %v, want the edited message", titles)
+	}
+}
+
+func TestApiNotModified(t *testing.T) {
+	website, key := createWebsite(t, "Not modified", "not-modified.example.com")
+	createMessage(t, website, "Cached")
+
+	resp := apiGet(t, "/api/v1/messages", http.Header{"X-Api-Key": {key}})
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		t.Fatalf("got %d with ETag %q, want a 200 with an ETag", resp.StatusCode, etag)
+	}
+
+	resp = apiGet(t, "/api/v1/messages", http.Header{"X-Api-Key": {key}, "If-None-Match": {etag}})
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("got %d, want a 304 for the current ETag", resp.StatusCode)
+	}
+
+	createMessage(t, website, "Added")
+	resp = apiGet(t, "/api/v1/messages", http.Header{"X-Api-Key": {key}, "If-None-Match": {etag}})
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("ETag") == etag {
+		t.Errorf("got %d with ETag %q, want a 200 with a new ETag once the messages changed", resp.StatusCode, resp.Header.Get("ETag"))
+	}
+}
+
+func TestApiPreflight(t *testing.T) {
+	createWebsite(t, "Preflight", "preflight.example.com")
+
+	preflight := func(origin string) *http.Response {
+		req, err := http.NewRequest("OPTIONS", server.URL+"/api/v1/messages", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", "GET")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	resp := preflight("https://preflight.example.com")
+	if resp.StatusCode != http.StatusNoContent || resp.Header.Get("Access-Control-Allow-Origin") != "https://preflight.example.com" {
+		t.Errorf("got %d allowing %q, want the origin of the website allowed", resp.StatusCode, resp.Header.Get("Access-Control-Allow-Origin"))
+	}
+
+	resp = preflight("https://evil.example.org")
+	if resp.StatusCode != http.StatusForbidden || resp.Header.Get("Access-Control-Allow-Origin") != "" {
+		t.Errorf("got %d allowing %q, want a 403 for an unknown origin", resp.StatusCode, resp.Header.Get("Access-Control-Allow-Origin"))
+	}
+}
+
+var (
+	previewTokenRegexp  = regexp.MustCompile(`msp_[A-Za-z0-9_.-]+`)
+	previewRevokeRegexp = regexp.MustCompile(`/preview-token/(\d+)`)
+)
+
+func TestApiRevokedPreviewToken(t *testing.T) {
+	website, key := createWebsite(t, "Preview", "preview.example.com")
+	messageId := createMessage(t, website, "Previewed")
+	tokenPath := fmt.Sprintf("/message/%d/preview-token", messageId)
+
+	section := submit(t, "POST", tokenPath, url.Values{"expires_in": {"day"}})
+	token := previewTokenRegexp.FindString(section)
+	revoke := previewRevokeRegexp.FindStringSubmatch(section)
+	if token == "" || revoke == nil {
+		t.Fatal("preview token not revealed")
+	}
+
+	header := http.Header{"X-Api-Key": {key}, "X-Preview-Token": {token}}
+	resp := apiGet(t, "/api/v1/messages", header)
+	if titles := decodeMessages(t, resp); fmt.Sprint(titles) != "[Previewed]" {
+		t.Fatalf("messages = %v, want the previewed message", titles)
+	}
+	if resp.Header.Get("Cache-Control") != "no-store" {
+		t.Errorf("Cache-Control = %q, want previews not stored", resp.Header.Get("Cache-Control"))
+	}
+
+	submit(t, "DELETE", tokenPath+"/"+revoke[1], nil)
+	if resp := apiGet(t, "/api/v1/messages", header); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("got %d, want a 403 for a revoked token", resp.StatusCode)
+	}
+}
+
+func TestApiPathSources(t *testing.T) {
+	website, key := createWebsite(t, "Paths", "paths.example.com")
+	form := messageForm(website, "Menu of the café")
+	form.Set(fmt.Sprintf("include_paths_%d", website.ID), "/café/*")
+	submit(t, "POST", "/message", form)
+
+	tests := []struct {
+		name    string
+		query   string
+		referer string
+		want    string
+	}{
+		{"encoded parameter", "/caf%C3%A9/menu", "", "[Menu of the café]"},
+		{"decoded parameter", "/café/menu", "", "[Menu of the café]"},
+		{"encoded Referer", "", "https://paths.example.com/caf%C3%A9/menu?table=4", "[Menu of the café]"},
+		{"encoded slash in the Referer", "", "https://paths.example.com/caf%C3%A9%2Fmenu", "[Menu of the café]"},
+		{"other parameter", "/cafe/menu", "", "[]"},
+		{"other Referer", "", "https://paths.example.com/cafe/menu", "[]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := "/api/v1/messages"
+			if tt.query != "" {
+				path += "?" + url.Values{"path": {tt.query}}.Encode()
+			}
+			header := http.Header{"X-Api-Key": {key}}
+			if tt.referer != "" {
+				header.Set("Referer", tt.referer)
+			}
+			if titles := decodeMessages(t, apiGet(t, path, header)); fmt.Sprint(titles) != tt.want {
+				t.Errorf("messages = %v, want %s", titles, tt.want)
+			}
+		})
+	}
+
+	if resp := apiGet(t, "/api/v1/messages?path=%2Fbad%25zz", http.Header{"X-Api-Key": {key}}); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("got %d, want a 400 for an invalid escape", resp.StatusCode)
+	}
+}
+
+func TestApiLoadFailureNotCached(t *testing.T) {
+	website, key := createWebsite(t, "Failure", "failure.example.com")
+	createMessage(t, website, "Unreachable")
+
+	if _, err := db.Query.Exec("ALTER TABLE message_translations RENAME TO message_translations_moved"); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if _, err := db.Query.Exec("ALTER TABLE message_translations_moved RENAME TO message_translations"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	resp := apiGet(t, "/api/v1/messages", http.Header{"X-Api-Key": {key}})
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("got %d, want a 500", resp.StatusCode)
+	}
+	if resp.Header.Get("Cache-Control") != "no-store" || resp.Header.Get("ETag") != "" {
+		t.Errorf("Cache-Control = %q and ETag = %q, want the failure not stored", resp.Header.Get("Cache-Control"), resp.Header.Get("ETag"))
+	}
+	var response v1.Response
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil || response.Error == "" {
+		t.Errorf("got %+v (%v), want a JSON error", response, err)
+	}
+}
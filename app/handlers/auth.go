@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"errors"
 	"messages/app/types"
+	"messages/plugins/auth"
 
 	"github.com/anthdm/superkit/kit"
 )
@@ -9,3 +11,11 @@ import (
 func HandleAuthentication(kit *kit.Kit) (kit.Auth, error) {
 	return types.AuthUser{}, nil
 }
+
+// verifyAdminRole returns an error unless the user is an administrator.
+func verifyAdminRole(auth auth.Auth) error {
+	if auth.Role != "admin" {
+		return errors.New("You are not allowed to perform this action")
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"messages/app/db"
+	"messages/app/helpers"
+	"messages/app/models"
+	"messages/app/views/languages"
+	"messages/plugins/auth"
+	"regexp"
+	"sync"
+	"time"
+
+	v "github.com/anthdm/superkit/validate"
+
+	"github.com/anthdm/superkit/kit"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// languageCatalogue caches the languages table, which is read by every API
+// request. Writes through the admin UI reload it.
+type languageCatalogue struct {
+	mu        sync.RWMutex
+	languages []*models.Language
+	loaded    bool
+}
+
+var languageList = &languageCatalogue{}
+
+// all returns every language of the catalogue, ordered by name.
+func (c *languageCatalogue) all() []*models.Language {
+	c.mu.RLock()
+	if c.loaded {
+		defer c.mu.RUnlock()
+		return c.languages
+	}
+	c.mu.RUnlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.loaded {
+		return c.languages
+	}
+
+	dbLanguages, err := models.Languages(
+		qm.OrderBy("name ASC"),
+	).All(context.Background(), db.Query)
+	if err != nil {
+		// Not marked as loaded, so the next call tries again.
+		slog.Error("failed to load languages", "err", err.Error())
+		return nil
+	}
+
+	c.languages = dbLanguages
+	c.loaded = true
+	return c.languages
+}
+
+func (c *languageCatalogue) find(code string) *models.Language {
+	for _, language := range c.all() {
+		if language.Code == code {
+			return language
+		}
+	}
+	return nil
+}
+
+func (c *languageCatalogue) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.languages = nil
+	c.loaded = false
+}
+
+// IsValidLanguage reports whether a language is enabled, so messages can be
+// written and served in it.
+func IsValidLanguage(lang string) bool {
+	language := languageList.find(lang)
+	return language != nil && language.Enabled
+}
+
+// getLanguageDirection returns the text direction of a language.
+func getLanguageDirection(lang string) string {
+	if language := languageList.find(lang); language != nil {
+		return language.Direction
+	}
+	return "ltr"
+}
+
+// getLanguageOptions returns the enabled languages for a select field.
+func getLanguageOptions() map[string]string {
+	options := make(map[string]string)
+	for _, language := range languageList.all() {
+		if language.Enabled {
+			options[language.Code] = language.Name
+		}
+	}
+	return options
+}
+
+// languageCodeRegexp matches lowercase language tags such as fr or pt-br.
+var languageCodeRegexp = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+var validLanguageCode = v.RuleSet{
+	Name: "languageCode",
+	MessageFunc: func(set v.RuleSet) string {
+		return "must be a lowercase language code (e.g. es or pt-br)"
+	},
+	ValidateFunc: func(rule v.RuleSet) bool {
+		str, _ := rule.FieldValue.(string)
+		return languageCodeRegexp.MatchString(str)
+	},
+}
+
+func HandleLanguagesList(kit *kit.Kit) error {
+	data := &languages.IndexPageData{
+		FormValues: getBaseLanguageFormValues(),
+	}
+
+	dbLanguagesList, err := models.Languages(
+		qm.OrderBy("name ASC"),
+	).All(kit.Request.Context(), db.Query)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	languagesList := make([]*languages.LanguageListItem, 0, len(dbLanguagesList))
+	for _, language := range dbLanguagesList {
+		languagesList = append(languagesList, &languages.LanguageListItem{
+			ID:        language.ID,
+			Code:      language.Code,
+			Name:      language.Name,
+			Direction: language.Direction,
+			Enabled:   language.Enabled,
+		})
+	}
+	data.LanguagesList = languagesList
+
+	return kit.Render(languages.Index(data))
+}
+
+func HandleLanguageGet(kit *kit.Kit) error {
+	languageId, err := helpers.GetIdFromUrl(kit)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	dbLanguage, err := models.FindLanguage(kit.Request.Context(), db.Query, languageId)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	data := &languages.PageLanguageEditData{
+		FormValues: &languages.LanguageFormValues{
+			ID:        dbLanguage.ID,
+			Code:      dbLanguage.Code,
+			Name:      dbLanguage.Name,
+			Direction: dbLanguage.Direction,
+			Enabled:   dbLanguage.Enabled,
+		},
+		FormErrors: v.Errors{},
+	}
+
+	return kit.Render(languages.PageLanguageEdit(data))
+}
+
+var createLanguageSchema = v.Schema{
+	"code":      v.Rules(v.Required, validLanguageCode),
+	"name":      v.Rules(v.Required, v.Max(100)),
+	"direction": v.Rules(v.Required, v.In([]string{"ltr", "rtl"})),
+	"enabled":   v.Rules(),
+}
+
+func HandleLanguageCreate(kit *kit.Kit) error {
+	formValues := getBaseLanguageFormValues()
+	errors := v.Errors{}
+
+	if err := verifyAdminRole(kit.Auth().(auth.Auth)); err != nil {
+		errors.Add("form", "You are not allowed to create languages")
+		return kit.Render(languages.LanguageForm(formValues, errors))
+	}
+
+	formValues.Enabled = false
+	if errors, ok := v.Request(kit.Request, formValues, createLanguageSchema); !ok {
+		return kit.Render(languages.LanguageForm(formValues, errors))
+	}
+
+	if languageList.find(formValues.Code) != nil {
+		errors.Add("code", "A language with this code already exists")
+		return kit.Render(languages.LanguageForm(formValues, errors))
+	}
+
+	dbLanguage := models.Language{
+		Code:      formValues.Code,
+		Name:      formValues.Name,
+		Direction: formValues.Direction,
+		Enabled:   formValues.Enabled,
+	}
+
+	// Inferring the columns would skip a false enabled, which defaults to true.
+	if err := dbLanguage.Insert(kit.Request.Context(), db.Query, boil.Whitelist(
+		models.LanguageColumns.Code,
+		models.LanguageColumns.Name,
+		models.LanguageColumns.Direction,
+		models.LanguageColumns.Enabled,
+		models.LanguageColumns.CreatedAt,
+		models.LanguageColumns.UpdatedAt,
+	)); err != nil {
+		errors.Add("form", "Failed to create language")
+		return kit.Render(languages.LanguageForm(formValues, errors))
+	}
+
+	languageList.invalidate()
+
+	return kit.Redirect(200, "/languages")
+}
+
+func HandleLanguageUpdate(kit *kit.Kit) error {
+	errors := v.Errors{}
+	formValues := getBaseLanguageFormValues()
+	var err error
+
+	if formValues.ID, err = helpers.GetIdFromUrl(kit); err != nil {
+		return err
+	}
+
+	dbLanguage, err := models.FindLanguage(kit.Request.Context(), db.Query, formValues.ID)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	if err := verifyAdminRole(kit.Auth().(auth.Auth)); err != nil {
+		errors.Add("form", "You are not allowed to update languages")
+		return kit.Render(languages.LanguageForm(formValues, errors))
+	}
+
+	formValues.Enabled = false
+	if errors, ok := v.Request(kit.Request, formValues, createLanguageSchema); !ok {
+		formValues.Code = dbLanguage.Code
+		return kit.Render(languages.LanguageForm(formValues, errors))
+	}
+	// The code is not editable, messages refer to it.
+	formValues.Code = dbLanguage.Code
+
+	if _, err := models.Languages(
+		models.LanguageWhere.ID.EQ(formValues.ID),
+	).UpdateAll(kit.Request.Context(), db.Query, models.M{
+		models.LanguageColumns.Name:      formValues.Name,
+		models.LanguageColumns.Direction: formValues.Direction,
+		models.LanguageColumns.Enabled:   formValues.Enabled,
+		models.LanguageColumns.UpdatedAt: time.Now().UTC(),
+	}); err != nil {
+		errors.Add("form", "Failed to update language")
+		return kit.Render(languages.LanguageForm(formValues, errors))
+	}
+
+	languageList.invalidate()
+
+	return kit.Redirect(200, "/languages")
+}
+
+func HandleLanguageDelete(kit *kit.Kit) error {
+	languageId, err := helpers.GetIdFromUrl(kit)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	if err := verifyAdminRole(kit.Auth().(auth.Auth)); err != nil {
+		return helpers.RenderNoticeError(kit, errors.New("You are not allowed to delete languages"))
+	}
+
+	dbLanguage, err := models.FindLanguage(kit.Request.Context(), db.Query, languageId)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	inUse, err := isLanguageInUse(kit.Request.Context(), dbLanguage.Code)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+	if inUse {
+		return helpers.RenderNoticeError(kit, errors.New("This language is used by messages or websites, disable it instead"))
+	}
+
+	if _, err := models.Languages(
+		models.LanguageWhere.ID.EQ(languageId),
+	).DeleteAll(kit.Request.Context(), db.Query); err != nil {
+		return helpers.RenderNoticeError(kit, errors.New("Failed to delete language"))
+	}
+
+	languageList.invalidate()
+
+	return kit.Redirect(200, "/languages")
+}
+
+// isLanguageInUse reports whether messages are written in a language or
+// websites fall back to it.
+func isLanguageInUse(ctx context.Context, code string) (bool, error) {
+	messagesExist, err := models.MessageTranslations(
+		models.MessageTranslationWhere.Language.EQ(code),
+	).Exists(ctx, db.Query)
+	if err != nil || messagesExist {
+		return messagesExist, err
+	}
+
+	return models.Websites(
+		models.WebsiteWhere.FallbackLanguage.EQ(code),
+	).Exists(ctx, db.Query)
+}
+
+func getBaseLanguageFormValues() *languages.LanguageFormValues {
+	return &languages.LanguageFormValues{
+		Direction: "ltr",
+		Enabled:   true,
+	}
+}
@@ -0,0 +1,7 @@
+package handlers
+
+// isListSeparator reports whether r separates the items of a list typed in a
+// form or passed as a query parameter: commas, spaces and new lines.
+func isListSeparator(r rune) bool {
+	return r == ',' || r == '\n' || r == '\r' || r == ' '
+}
@@ -0,0 +1,257 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"messages/app"
+	"messages/app/db"
+	"messages/app/locales"
+	"messages/app/models"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anthdm/superkit/kit"
+	"github.com/go-chi/chi/v5"
+	"github.com/invopop/ctxi18n"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// server serves the routes of the application on an in-memory database.
+var server *httptest.Server
+
+// admin is a client logged in as an administrator.
+var admin *http.Client
+
+func TestMain(m *testing.M) {
+	if err := setup(); err != nil {
+		log.Fatal(err)
+	}
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
+// setup configures the application as cmd/app does, on an in-memory database
+// migrated from app/db/migrations, and logs an administrator in.
+func setup() error {
+	for name, value := range map[string]string{
+		"SUPERKIT_SECRET":           "0123456789abcdef0123456789abcdef",
+		"SUPERKIT_AUTH_SKIP_VERIFY": "true",
+		"API_RATE_LIMIT":            "0",
+		"APP_URL":                   "",
+		"TRUST_PROXY":               "false",
+	} {
+		os.Setenv(name, value)
+	}
+
+	// kit.Setup loads the .env file of the working directory.
+	dir, err := os.MkdirTemp("", "handlers")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	if err := os.WriteFile(filepath.Join(dir, ".env"), nil, 0o644); err != nil {
+		return err
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if err := os.Chdir(dir); err != nil {
+		return err
+	}
+	kit.Setup()
+	if err := os.Chdir(wd); err != nil {
+		return err
+	}
+
+	if err := ctxi18n.LoadWithDefault(locales.LocalesFs, "en"); err != nil {
+		return err
+	}
+	if err := db.Open("sqlite3", "file:handlers_test?mode=memory&cache=shared"); err != nil {
+		return err
+	}
+	if err := migrate(filepath.Join("..", "db", "migrations")); err != nil {
+		return err
+	}
+
+	router := chi.NewMux()
+	app.InitializeMiddleware(router)
+	kit.UseErrorHandler(app.ErrorHandler)
+	router.HandleFunc("/*", kit.Handler(app.NotFoundHandler))
+	app.InitializeRoutes(router)
+	server = httptest.NewServer(router)
+
+	admin, err = login("admin@example.com", "admin")
+	return err
+}
+
+// migrate applies the up sections of the goose migrations of a directory.
+func migrate(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		var up []string
+		on := false
+		for _, line := range strings.Split(string(data), "\n") {
+			switch {
+			case strings.HasPrefix(line, "-- +goose Up"):
+				on = true
+			case strings.HasPrefix(line, "-- +goose Down"):
+				on = false
+			case on && !strings.HasPrefix(line, "-- +goose Statement"):
+				up = append(up, line)
+			}
+		}
+		if _, err := db.Query.Exec(strings.Join(up, "\n")); err != nil {
+			return fmt.Errorf("%s: %w", filepath.Base(file), err)
+		}
+	}
+	return nil
+}
+
+// login creates a user and returns a client holding its session.
+func login(email string, role string) (*http.Client, error) {
+	const password = "Secret!Pass1"
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		return nil, err
+	}
+	user := &models.User{
+		Email:        email,
+		PasswordHash: string(hash),
+		FirstName:    "Test",
+		LastName:     "User",
+		Role:         role,
+		Timezone:     "UTC",
+	}
+	if err := user.Insert(context.Background(), db.Query, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.PostForm(server.URL+"/login", url.Values{"email": {email}, "password": {password}})
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusSeeOther {
+		return nil, fmt.Errorf("login: got %d", resp.StatusCode)
+	}
+	return client, nil
+}
+
+// submit sends a form of the admin UI as HTMX does, and returns the body of
+// the response.
+func submit(t *testing.T, method string, path string, form url.Values) string {
+	t.Helper()
+	req, err := http.NewRequest(method, server.URL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("HX-Request", "true")
+	resp, err := admin.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode >= 400 {
+		t.Fatalf("%s %s: got %d: %s", method, path, resp.StatusCode, body)
+	}
+	return string(body)
+}
+
+var apiKeyRegexp = regexp.MustCompile(`msk_[A-Za-z0-9_-]{43}`)
+
+// createWebsite creates a website through the admin UI, and returns it along
+// with an API key.
+func createWebsite(t *testing.T, name string, domain string) (*models.Website, string) {
+	t.Helper()
+	submit(t, "POST", "/website", url.Values{"name": {name}, "domain": {domain}, "allow_origin_lookup": {"on"}})
+	website, err := models.Websites(models.WebsiteWhere.Name.EQ(name)).One(context.Background(), db.Query)
+	if err != nil {
+		t.Fatalf("website %s not created: %v", name, err)
+	}
+
+	key := apiKeyRegexp.FindString(submit(t, "POST", fmt.Sprintf("/website/%d/key", website.ID), url.Values{"name": {"test"}}))
+	if key == "" {
+		t.Fatal("API key not revealed")
+	}
+	return website, key
+}
+
+// messageForm returns the form of an English message displayed on a website
+// since 2020.
+func messageForm(website *models.Website, title string) url.Values {
+	return url.Values{
+		"title_en":      {title},
+		"message_en":    {"Some **news**"},
+		"type":          {"info"},
+		"dateRangeFrom": {"2020-01-01T00:00:00Z"},
+		"dateRangeTo":   {time.Now().AddDate(1, 0, 0).UTC().Format(time.RFC3339)},
+		"websites":      {fmt.Sprintf("%d", website.ID)},
+		"timezone":      {"UTC"},
+	}
+}
+
+// createMessage creates a message through the admin UI and returns its ID.
+func createMessage(t *testing.T, website *models.Website, title string) int64 {
+	t.Helper()
+	submit(t, "POST", "/message", messageForm(website, title))
+	translation, err := models.MessageTranslations(
+		models.MessageTranslationWhere.Title.EQ(title),
+	).One(context.Background(), db.Query)
+	if err != nil {
+		t.Fatalf("message %s not created: %v", title, err)
+	}
+	return translation.MessageID
+}
+
+// apiGet sends a request to the public API.
+func apiGet(t *testing.T, path string, header http.Header) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest("GET", server.URL+path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"messages/app/models"
+	"messages/app/schedule"
+	"messages/app/views/messages"
+	"strings"
+	"time"
+
+	v "github.com/anthdm/superkit/validate"
+)
+
+// messageDailyWindow is the validated daily window of the message form, as
+// stored: its hours, empty for the whole day, and its days of the week, empty
+// for every day.
+type messageDailyWindow struct {
+	from     string
+	to       string
+	weekdays string
+}
+
+// parseMessageDailyWindow returns the daily window of the message form.
+// Validation errors are added to errors.
+func parseMessageDailyWindow(formValues *messages.MessageFormValues, errors v.Errors) (*messageDailyWindow, bool) {
+	dailyWindow := &messageDailyWindow{}
+
+	if formValues.DailyFrom != "" || formValues.DailyTo != "" {
+		from, err := schedule.ParseTimeOfDay(formValues.DailyFrom)
+		if err != nil {
+			errors.Add("dailyFrom", err.Error())
+			return nil, false
+		}
+		to, err := schedule.ParseTimeOfDay(formValues.DailyTo)
+		if err != nil {
+			errors.Add("dailyTo", err.Error())
+			return nil, false
+		}
+		dailyWindow.from, dailyWindow.to = from.String(), to.String()
+	}
+
+	weekdays, err := schedule.ParseWeekdays(strings.Join(formValues.Weekdays, ","))
+	if err != nil {
+		errors.Add("weekdays", err.Error())
+		return nil, false
+	}
+	dailyWindow.weekdays = weekdays.String()
+
+	return dailyWindow, true
+}
+
+// getMessageDailyWindow returns the daily window of a message read in loc,
+// nil when the message is displayed all day, every day. A window that cannot
+// be read, which the form prevents, is ignored.
+func getMessageDailyWindow(message *models.Message, loc *time.Location) *schedule.DailyWindow {
+	if message.DailyFrom == "" && message.Weekdays == "" {
+		return nil
+	}
+
+	dailyWindow := &schedule.DailyWindow{Location: loc}
+	if message.DailyFrom != "" {
+		from, errFrom := schedule.ParseTimeOfDay(message.DailyFrom)
+		to, errTo := schedule.ParseTimeOfDay(message.DailyTo)
+		if errFrom != nil || errTo != nil {
+			return nil
+		}
+		dailyWindow.From, dailyWindow.To = from, to
+	}
+
+	weekdays, err := schedule.ParseWeekdays(message.Weekdays)
+	if err != nil {
+		return nil
+	}
+	dailyWindow.Weekdays = weekdays
+
+	return dailyWindow
+}
+
+// getMessageWeekdayCodes returns the days of the week of a message, as checked
+// in the message form.
+func getMessageWeekdayCodes(message *models.Message) []string {
+	weekdays, _ := schedule.ParseWeekdays(message.Weekdays)
+	return weekdays.Codes()
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"messages/app/db"
+	"messages/app/helpers"
+	"messages/app/models"
+	"messages/app/views/messages"
+	"net/http"
+	"strings"
+
+	v "github.com/anthdm/superkit/validate"
+)
+
+// parseMessagePaths reads the include_paths_<id> and exclude_paths_<id> fields
+// of the message form for each of the given websites. Validation errors are
+// added to errors.
+func parseMessagePaths(r *http.Request, websiteIds []string, errors v.Errors) (map[string]*messages.MessagePathValues, bool) {
+	paths := make(map[string]*messages.MessagePathValues)
+	valid := true
+
+	for _, websiteId := range websiteIds {
+		websitePaths := &messages.MessagePathValues{
+			Include: normalizePathPatterns(r.FormValue("include_paths_" + websiteId)),
+			Exclude: normalizePathPatterns(r.FormValue("exclude_paths_" + websiteId)),
+		}
+		if websitePaths.Include == "" && websitePaths.Exclude == "" {
+			continue
+		}
+		paths[websiteId] = websitePaths
+
+		for _, pattern := range helpers.ParsePathPatterns(websitePaths.Include + "\n" + websitePaths.Exclude) {
+			if !helpers.IsValidPathPattern(pattern) {
+				errors.Add("paths_"+websiteId, fmt.Sprintf("%s must be a page path starting with / (e.g. /checkout/*)", pattern))
+				valid = false
+				break
+			}
+		}
+	}
+
+	return paths, valid
+}
+
+// normalizePathPatterns formats a list of path patterns for storage.
+func normalizePathPatterns(list string) string {
+	return strings.Join(helpers.ParsePathPatterns(list), "\n")
+}
+
+// getMessagePathValues returns the page targeting of a message as form
+// values, keyed by website id.
+func getMessagePathValues(ctx context.Context, messageId int64) (map[string]*messages.MessagePathValues, error) {
+	dbWebsitesMessages, err := models.WebsitesMessages(
+		models.WebsitesMessageWhere.MessageId.EQ(messageId),
+	).All(ctx, db.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make(map[string]*messages.MessagePathValues, len(dbWebsitesMessages))
+	for _, websiteMessage := range dbWebsitesMessages {
+		if websiteMessage.IncludePaths == "" && websiteMessage.ExcludePaths == "" {
+			continue
+		}
+		paths[fmt.Sprintf("%d", websiteMessage.WebsiteId)] = &messages.MessagePathValues{
+			Include: websiteMessage.IncludePaths,
+			Exclude: websiteMessage.ExcludePaths,
+		}
+	}
+	return paths, nil
+}
+
+// getWebsiteOptionIds returns the ids of the websites offered by the message
+// form.
+func getWebsiteOptionIds(settings *messages.MessageFormSettings) []string {
+	ids := make([]string, 0, len(settings.Websites))
+	for id := range settings.Websites {
+		ids = append(ids, id)
+	}
+	return ids
+}
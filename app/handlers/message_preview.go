@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"fmt"
+	"messages/app/db"
+	"messages/app/helpers"
+	"messages/app/models"
+	"messages/app/views/messages"
+	"strconv"
+
+	"github.com/anthdm/superkit/kit"
+	"github.com/go-chi/chi/v5"
+	"github.com/invopop/ctxi18n/i18n"
+)
+
+// HandleMessagePreview renders a translation of the message form as delivered
+// to each of the selected websites, in the slot it occupies, or with the
+// default policy when none is selected.
+func HandleMessagePreview(kit *kit.Kit) error {
+	if err := kit.Request.ParseForm(); err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	lang := chi.URLParam(kit.Request, "lang")
+	content := []byte(kit.Request.FormValue("message_" + lang))
+
+	websiteIds := make([]int64, 0)
+	for _, value := range kit.Request.Form["websites"] {
+		websiteId, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		websiteIds = append(websiteIds, websiteId)
+	}
+
+	items := make([]*messages.MessagePreviewItem, 0)
+	if len(websiteIds) == 0 {
+		items = append(items, &messages.MessagePreviewItem{
+			WebsiteName: i18n.T(kit.Request.Context(), "messages.form.preview.default_policy"),
+			HTML:        string(helpers.SanitizeHTML(mdToHTML(content), helpers.SanitizePolicy{})),
+		})
+		return kit.Render(messages.MessagePreview(items))
+	}
+
+	dbWebsites, err := models.Websites(
+		models.WebsiteWhere.ID.IN(websiteIds),
+	).All(kit.Request.Context(), db.Query)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	for _, dbWebsite := range dbWebsites {
+		slot := kit.Request.FormValue(fmt.Sprintf("slot_%d", dbWebsite.ID))
+		if slot == "" {
+			slot = defaultSlot
+		}
+		items = append(items, &messages.MessagePreviewItem{
+			WebsiteName: dbWebsite.Name,
+			Slot:        slot,
+			HTML:        string(helpers.SanitizeHTML(mdToHTML(content), getWebsiteSanitizePolicy(dbWebsite))),
+		})
+	}
+
+	return kit.Render(messages.MessagePreview(items))
+}
@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"messages/app/db"
+	"messages/app/models"
+	"messages/app/schedule"
+	"messages/app/views/messages"
+	"strconv"
+	"time"
+
+	"github.com/anthdm/superkit/kit"
+	v "github.com/anthdm/superkit/validate"
+)
+
+// recurrencePreviewOccurrences is the number of occurrences listed by the
+// preview of the recurrence editor.
+const recurrencePreviewOccurrences = 5
+
+// messageRecurrence is the validated recurrence of the message form.
+type messageRecurrence struct {
+	rule       *schedule.Recurrence
+	duration   time.Duration
+	exceptions []schedule.Day
+}
+
+// HandleMessageRecurrencePreview lists the next displays of the schedule of
+// the message form, within its daily window, in its timezone.
+func HandleMessageRecurrencePreview(kit *kit.Kit) error {
+	formValues := &messages.MessageFormValues{}
+	// Only the schedule is previewed, the other fields may still be empty.
+	v.Request(kit.Request, formValues, createMessageSchema)
+	errors := v.Errors{}
+
+	displayFrom, displayTo, ok := parseMessageSchedule(formValues, errors)
+	if !ok {
+		return kit.Render(messages.RecurrencePreview(nil, formValues.Timezone, errors))
+	}
+	recurrence, ok := parseMessageRecurrence(formValues, errors)
+	if !ok {
+		return kit.Render(messages.RecurrencePreview(nil, formValues.Timezone, errors))
+	}
+	if err := parseMultiSelectFields(kit.Request, formValues); err != nil {
+		errors.Add("weekdays", err.Error())
+		return kit.Render(messages.RecurrencePreview(nil, formValues.Timezone, errors))
+	}
+	dailyWindow, ok := parseMessageDailyWindow(formValues, errors)
+	if !ok {
+		return kit.Render(messages.RecurrencePreview(nil, formValues.Timezone, errors))
+	}
+
+	loc, _ := schedule.LoadLocation(formValues.Timezone)
+	recurrenceRule, recurrenceExceptions, recurrenceDuration := getMessageRecurrenceColumns(recurrence)
+	dbMessage := &models.Message{
+		DisplayFrom:          displayFrom,
+		DisplayTo:            displayTo,
+		Timezone:             formValues.Timezone,
+		RecurrenceRule:       recurrenceRule,
+		RecurrenceExceptions: recurrenceExceptions,
+		RecurrenceDuration:   recurrenceDuration,
+		DailyFrom:            dailyWindow.from,
+		DailyTo:              dailyWindow.to,
+		Weekdays:             dailyWindow.weekdays,
+	}
+
+	// The daily window is read in the timezone of the first website selected.
+	var website *models.Website
+	if len(formValues.Websites) > 0 {
+		websiteId, err := strconv.ParseInt(formValues.Websites[0], 10, 64)
+		if err == nil {
+			website, _ = models.FindWebsite(kit.Request.Context(), db.Query, websiteId)
+		}
+	}
+	messageSchedule := getMessageSchedule(dbMessage, website)
+
+	// Occurrences already over are not listed, unless the whole schedule is.
+	after := time.Now().UTC()
+	if !displayTo.After(after) {
+		after = displayFrom
+	}
+	occurrences := make([]*messages.RecurrenceOccurrence, 0, recurrencePreviewOccurrences)
+	for _, window := range messageSchedule.Upcoming(after, recurrencePreviewOccurrences) {
+		occurrences = append(occurrences, &messages.RecurrenceOccurrence{
+			Start: window.Start.In(loc),
+			End:   window.End.In(loc),
+		})
+	}
+
+	return kit.Render(messages.RecurrencePreview(occurrences, loc.String(), errors))
+}
+
+// parseMessageRecurrence returns the recurrence of the message form, nil when
+// it has no rule. Validation errors are added to errors.
+func parseMessageRecurrence(formValues *messages.MessageFormValues, errors v.Errors) (*messageRecurrence, bool) {
+	if formValues.RecurrenceRule == "" {
+		return nil, true
+	}
+
+	rule, err := schedule.ParseRecurrence(formValues.RecurrenceRule)
+	if err != nil {
+		errors.Add("recurrenceRule", err.Error())
+		return nil, false
+	}
+
+	duration, err := schedule.ParseDuration(formValues.RecurrenceDuration)
+	if err != nil {
+		errors.Add("recurrenceDuration", err.Error())
+		return nil, false
+	}
+
+	exceptions, err := schedule.ParseExceptions(formValues.RecurrenceExceptions)
+	if err != nil {
+		errors.Add("recurrenceExceptions", err.Error())
+		return nil, false
+	}
+
+	return &messageRecurrence{
+		rule:       rule,
+		duration:   duration,
+		exceptions: exceptions,
+	}, true
+}
+
+// getMessageRecurrenceColumns returns the values of the recurrence columns of
+// a message for a recurrence of the message form.
+func getMessageRecurrenceColumns(recurrence *messageRecurrence) (string, string, int64) {
+	if recurrence == nil {
+		return "", "", 0
+	}
+	return recurrence.rule.String(), schedule.FormatExceptions(recurrence.exceptions), int64(recurrence.duration / time.Minute)
+}
+
+// getMessageSchedule returns the schedule of a message on a website, whose
+// timezone its daily window is read in, or in its own timezone when website is
+// nil. A message whose recurrence cannot be read, which the form prevents, is
+// displayed for its whole display period.
+func getMessageSchedule(message *models.Message, website *models.Website) *schedule.Schedule {
+	messageSchedule := &schedule.Schedule{
+		Start:    message.DisplayFrom,
+		End:      message.DisplayTo,
+		Location: getMessageLocation(message),
+	}
+	if website != nil {
+		messageSchedule.Daily = getMessageDailyWindow(message, getWebsiteLocation(website))
+	} else {
+		messageSchedule.Daily = getMessageDailyWindow(message, messageSchedule.Location)
+	}
+	if message.RecurrenceRule == "" || message.RecurrenceDuration <= 0 {
+		return messageSchedule
+	}
+
+	rule, err := schedule.ParseRecurrence(message.RecurrenceRule)
+	if err != nil {
+		return messageSchedule
+	}
+	exceptions, err := schedule.ParseExceptions(message.RecurrenceExceptions)
+	if err != nil {
+		return messageSchedule
+	}
+
+	messageSchedule.Recurrence = rule
+	messageSchedule.Duration = time.Duration(message.RecurrenceDuration) * time.Minute
+	messageSchedule.Exceptions = exceptions
+	return messageSchedule
+}
+
+// getMessageSchedules returns the schedule of a message on each of its
+// websites, or in its own timezone when it has none.
+func getMessageSchedules(message *models.Message, websites []*models.Website) []*schedule.Schedule {
+	if len(websites) == 0 {
+		return []*schedule.Schedule{getMessageSchedule(message, nil)}
+	}
+	schedules := make([]*schedule.Schedule, 0, len(websites))
+	for _, website := range websites {
+		schedules = append(schedules, getMessageSchedule(message, website))
+	}
+	return schedules
+}
+
+// getMessageNextOccurrence returns the start of the next display of a message
+// on any of its websites after an instant, the zero time when it will not be
+// displayed again.
+func getMessageNextOccurrence(message *models.Message, websites []*models.Website, after time.Time) time.Time {
+	var next time.Time
+	for _, messageSchedule := range getMessageSchedules(message, websites) {
+		for _, window := range messageSchedule.Upcoming(after, 2) {
+			if window.Start.After(after) {
+				if next.IsZero() || window.Start.Before(next) {
+					next = window.Start
+				}
+				break
+			}
+		}
+	}
+	return next
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"context"
+	"messages/app/db"
+	"messages/app/models"
+	"messages/app/views/messages"
+	"slices"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// messageStatsChartHours is the period covered by the charts of the message
+// edit page, in hourly buckets.
+const messageStatsChartHours = 7 * 24
+
+// messageStatsTotals holds the sums of the hourly buckets of a message, on a
+// website when grouped by website.
+type messageStatsTotals struct {
+	MessageID   int64 `boil:"message_id"`
+	WebsiteID   int64 `boil:"website_id"`
+	Impressions int64 `boil:"impressions"`
+	Clicks      int64 `boil:"clicks"`
+	Dismissals  int64 `boil:"dismissals"`
+}
+
+// getMessagesStatsTotals returns the totals of every message, by message id.
+func getMessagesStatsTotals(ctx context.Context) (map[int64]*messages.MessageStatsTotals, error) {
+	var rows []*messageStatsTotals
+	if err := models.MessageStats(
+		qm.Select("message_id", "0 AS website_id", "SUM(impressions) AS impressions", "SUM(clicks) AS clicks", "SUM(dismissals) AS dismissals"),
+		qm.GroupBy("message_id"),
+	).Bind(ctx, db.Query, &rows); err != nil {
+		return nil, err
+	}
+
+	totals := make(map[int64]*messages.MessageStatsTotals, len(rows))
+	for _, row := range rows {
+		totals[row.MessageID] = &messages.MessageStatsTotals{
+			Impressions: row.Impressions,
+			Clicks:      row.Clicks,
+			Dismissals:  row.Dismissals,
+		}
+	}
+	return totals, nil
+}
+
+// messageLinkClicksTotal holds the clicks on a tracked link of a message on a
+// website.
+type messageLinkClicksTotal struct {
+	WebsiteID int64  `boil:"website_id"`
+	URL       string `boil:"url"`
+	Clicks    int64  `boil:"clicks"`
+}
+
+// getMessageWebsiteStats returns the totals of a message on each website it
+// was displayed on, along with its hourly impressions and clicks over the
+// last messageStatsChartHours hours and the clicks on its tracked links.
+func getMessageWebsiteStats(ctx context.Context, messageId int64, now time.Time) ([]*messages.MessageWebsiteStats, error) {
+	var rows []*messageStatsTotals
+	if err := models.MessageStats(
+		qm.Select("message_id", "website_id", "SUM(impressions) AS impressions", "SUM(clicks) AS clicks", "SUM(dismissals) AS dismissals"),
+		models.MessageStatWhere.MessageID.EQ(messageId),
+		qm.GroupBy("message_id, website_id"),
+		qm.OrderBy("website_id ASC"),
+	).Bind(ctx, db.Query, &rows); err != nil {
+		return nil, err
+	}
+
+	var linkRows []*messageLinkClicksTotal
+	if err := models.MessageLinkClicks(
+		qm.Select("website_id", "url", "SUM(clicks) AS clicks"),
+		models.MessageLinkClickWhere.MessageID.EQ(messageId),
+		qm.GroupBy("website_id, url"),
+		qm.OrderBy("clicks DESC, url ASC"),
+	).Bind(ctx, db.Query, &linkRows); err != nil {
+		return nil, err
+	}
+
+	// Websites tracking links without reporting events only have link clicks.
+	for _, linkRow := range linkRows {
+		if !slices.ContainsFunc(rows, func(row *messageStatsTotals) bool { return row.WebsiteID == linkRow.WebsiteID }) {
+			rows = append(rows, &messageStatsTotals{MessageID: messageId, WebsiteID: linkRow.WebsiteID})
+		}
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	end := now.UTC().Truncate(time.Hour)
+	start := end.Add(-(messageStatsChartHours - 1) * time.Hour)
+	dbStats, err := models.MessageStats(
+		models.MessageStatWhere.MessageID.EQ(messageId),
+		models.MessageStatWhere.Hour.GTE(start),
+	).All(ctx, db.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	dbWebsites, err := models.Websites().All(ctx, db.Query)
+	if err != nil {
+		return nil, err
+	}
+	websiteNames := make(map[int64]string, len(dbWebsites))
+	for _, dbWebsite := range dbWebsites {
+		websiteNames[dbWebsite.ID] = dbWebsite.Name
+	}
+
+	stats := make([]*messages.MessageWebsiteStats, 0, len(rows))
+	for _, row := range rows {
+		websiteStats := &messages.MessageWebsiteStats{
+			WebsiteName: websiteNames[row.WebsiteID],
+			Totals: messages.MessageStatsTotals{
+				Impressions: row.Impressions,
+				Clicks:      row.Clicks,
+				Dismissals:  row.Dismissals,
+			},
+			Impressions: make([]int64, messageStatsChartHours),
+			Clicks:      make([]int64, messageStatsChartHours),
+			Links:       make([]*messages.MessageLinkStats, 0),
+		}
+		for _, linkRow := range linkRows {
+			if linkRow.WebsiteID == row.WebsiteID {
+				websiteStats.Links = append(websiteStats.Links, &messages.MessageLinkStats{
+					URL:    linkRow.URL,
+					Clicks: linkRow.Clicks,
+				})
+			}
+		}
+		// The buckets of every language are added up.
+		for _, dbStat := range dbStats {
+			if dbStat.WebsiteID != row.WebsiteID {
+				continue
+			}
+			i := int(dbStat.Hour.UTC().Sub(start) / time.Hour)
+			if i < 0 || i >= messageStatsChartHours {
+				continue
+			}
+			websiteStats.Impressions[i] += dbStat.Impressions
+			websiteStats.Clicks[i] += dbStat.Clicks
+		}
+		stats = append(stats, websiteStats)
+	}
+	return stats, nil
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"context"
+	"messages/app/db"
+	"messages/app/models"
+	"messages/app/views/messages"
+	"net/http"
+	"time"
+
+	v "github.com/anthdm/superkit/validate"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// findTranslation returns the translation of a message in a language, if any.
+func findTranslation(translations models.MessageTranslationSlice, lang string) *models.MessageTranslation {
+	for _, translation := range translations {
+		if translation.Language == lang {
+			return translation
+		}
+	}
+	return nil
+}
+
+// getMessageFormLanguages returns the tabs of the message form: the enabled
+// languages, plus the disabled ones the message already has a translation in.
+func getMessageFormLanguages(translations map[string]*messages.MessageTranslationValues) []*messages.MessageFormLanguage {
+	formLanguages := make([]*messages.MessageFormLanguage, 0)
+	for _, language := range languageList.all() {
+		if _, ok := translations[language.Code]; !ok && !language.Enabled {
+			continue
+		}
+		formLanguages = append(formLanguages, &messages.MessageFormLanguage{
+			Code:      language.Code,
+			Name:      language.Name,
+			Direction: language.Direction,
+			Enabled:   language.Enabled,
+		})
+	}
+	return formLanguages
+}
+
+// parseMessageTranslations reads the title_<code> and message_<code> fields
+// of the message form. Translations in disabled languages are only accepted
+// when the message already has them. Validation errors are added to errors.
+func parseMessageTranslations(r *http.Request, existing map[string]*messages.MessageTranslationValues, errors v.Errors) (map[string]*messages.MessageTranslationValues, bool) {
+	translations := make(map[string]*messages.MessageTranslationValues)
+	hasEnabledTranslation := false
+	valid := true
+
+	for _, language := range languageList.all() {
+		if _, ok := existing[language.Code]; !ok && !language.Enabled {
+			continue
+		}
+
+		translation := &messages.MessageTranslationValues{
+			Title:   r.FormValue("title_" + language.Code),
+			Message: r.FormValue("message_" + language.Code),
+		}
+		if translation.Title == "" && translation.Message == "" {
+			continue
+		}
+		translations[language.Code] = translation
+
+		if translation.Title == "" {
+			errors.Add("title_"+language.Code, "This field is required")
+			valid = false
+		}
+		if translation.Message == "" {
+			errors.Add("message_"+language.Code, "This field is required")
+			valid = false
+		}
+		if language.Enabled {
+			hasEnabledTranslation = true
+		}
+	}
+
+	if !hasEnabledTranslation {
+		errors.Add("translations", "At least one translation in an enabled language is required")
+		valid = false
+	}
+
+	return translations, valid
+}
+
+// getMessageTranslationValues returns the translations of a message as form
+// values, keyed by language code.
+func getMessageTranslationValues(ctx context.Context, messageId int64) (map[string]*messages.MessageTranslationValues, error) {
+	dbTranslations, err := models.MessageTranslations(
+		models.MessageTranslationWhere.MessageID.EQ(messageId),
+	).All(ctx, db.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	translations := make(map[string]*messages.MessageTranslationValues, len(dbTranslations))
+	for _, dbTranslation := range dbTranslations {
+		translations[dbTranslation.Language] = &messages.MessageTranslationValues{
+			Title:   dbTranslation.Title,
+			Message: dbTranslation.Content,
+		}
+	}
+	return translations, nil
+}
+
+// upsertMessageTranslations replaces the translations of a message.
+func upsertMessageTranslations(ctx context.Context, messageId int64, translations map[string]*messages.MessageTranslationValues) error {
+	dbTranslations, err := models.MessageTranslations(
+		models.MessageTranslationWhere.MessageID.EQ(messageId),
+	).All(ctx, db.Query)
+	if err != nil {
+		return err
+	}
+
+	for _, dbTranslation := range dbTranslations {
+		translation, ok := translations[dbTranslation.Language]
+		if !ok {
+			if _, err := dbTranslation.Delete(ctx, db.Query); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if dbTranslation.Title == translation.Title && dbTranslation.Content == translation.Message {
+			continue
+		}
+		if _, err := models.MessageTranslations(
+			models.MessageTranslationWhere.ID.EQ(dbTranslation.ID),
+		).UpdateAll(ctx, db.Query, models.M{
+			models.MessageTranslationColumns.Title:     translation.Title,
+			models.MessageTranslationColumns.Content:   translation.Message,
+			models.MessageTranslationColumns.UpdatedAt: time.Now().UTC(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for lang, translation := range translations {
+		if findTranslation(dbTranslations, lang) != nil {
+			continue
+		}
+		dbTranslation := &models.MessageTranslation{
+			MessageID: messageId,
+			Language:  lang,
+			Title:     translation.Title,
+			Content:   translation.Message,
+		}
+		if err := dbTranslation.Insert(ctx, db.Query, boil.Infer()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
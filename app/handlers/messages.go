@@ -2,11 +2,11 @@ package handlers
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"messages/app/db"
 	"messages/app/helpers"
 	"messages/app/models"
+	"messages/app/schedule"
 	"messages/app/types"
 	"messages/app/views/messages"
 	"messages/plugins/auth"
@@ -25,28 +25,49 @@ import (
 
 func HandleMessagesList(kit *kit.Kit) error {
 	data := &messages.IndexPageData{
-		FormValues:   &messages.MessageFormValues{},
-		FormSettings: getBaseMessageFormSettings(kit.Request.Context()),
+		FormValues:     &messages.MessageFormValues{Timezone: getUserLocation(kit).String()},
+		FormSettings:   getBaseMessageFormSettings(kit.Request.Context()),
+		LanguageFilter: kit.Request.URL.Query().Get("language"),
+		Languages:      make(map[string]string),
 	}
 
-	dbMessagesList, err := models.Messages(
+	// Disabled languages remain listed, their messages are still stored.
+	for _, language := range languageList.all() {
+		data.Languages[language.Code] = language.Name
+	}
+
+	queryMods := []qm.QueryMod{
+		qm.Load(models.MessageRels.MessageTranslations),
+		// The daily windows of the status are read in the timezones of the
+		// websites.
+		qm.Load(qm.Rels(models.MessageRels.MessageIdWebsitesMessages, models.WebsitesMessageRels.WebsiteIdWebsite)),
 		qm.OrderBy("display_from DESC"),
-	).All(kit.Request.Context(), db.Query)
+	}
+	if data.LanguageFilter != "" {
+		queryMods = append(queryMods, qm.Where(
+			"id IN (SELECT message_id FROM message_translations WHERE language = ?)", data.LanguageFilter,
+		))
+	}
+
+	dbMessagesList, err := models.Messages(queryMods...).All(kit.Request.Context(), db.Query)
 	if err != nil {
 		return err
 	}
 
+	statsTotals, err := getMessagesStatsTotals(kit.Request.Context())
+	if err != nil {
+		return err
+	}
+
+	uiLanguage := i18n.GetLocale(kit.Request.Context()).Code().String()
+	loc := getUserLocation(kit)
 	messagesList := make([]*messages.MessageListItem, 0, len(dbMessagesList))
 	for _, dbMessage := range dbMessagesList {
-		messagesList = append(messagesList, &messages.MessageListItem{
-			ID:          dbMessage.ID,
-			Title:       dbMessage.Title,
-			DisplayFrom: dbMessage.DisplayFrom,
-			DisplayTo:   dbMessage.DisplayTo,
-			Type:        dbMessage.Type,
-			Language:    dbMessage.Language,
-			Status:      getMessageStatus(kit.Request.Context(), dbMessage),
-		})
+		item := getMessageListItem(kit.Request.Context(), dbMessage, uiLanguage, loc)
+		if totals, ok := statsTotals[dbMessage.ID]; ok {
+			item.Stats = *totals
+		}
+		messagesList = append(messagesList, item)
 	}
 	data.MessagesList = messagesList
 
@@ -75,19 +96,57 @@ func HandleMessageGet(kit *kit.Kit) error {
 		websites = append(websites, fmt.Sprintf("%d", website.WebsiteId))
 	}
 
+	translations, err := getMessageTranslationValues(kit.Request.Context(), messageId)
+	if err != nil {
+		return err
+	}
+
+	paths, err := getMessagePathValues(kit.Request.Context(), messageId)
+	if err != nil {
+		return err
+	}
+
+	slots, err := getMessageSlotValues(kit.Request.Context(), messageId)
+	if err != nil {
+		return err
+	}
+
+	stats, err := getMessageWebsiteStats(kit.Request.Context(), messageId, time.Now())
+	if err != nil {
+		return err
+	}
+
+	previewTokens, err := getPreviewTokensSectionData(kit.Request.Context(), messageId, getUserLocation(kit))
+	if err != nil {
+		return err
+	}
+
 	data := &messages.PageMessageEditData{
 		FormValues: &messages.MessageFormValues{
 			ID:            messageId,
-			DateRangeFrom: dbMessage.DisplayFrom.Format(time.RFC3339),
-			DateRangeTo:   dbMessage.DisplayTo.Format(time.RFC3339),
-			Message:       dbMessage.Message,
-			Title:         dbMessage.Title,
+			DateRangeFrom: schedule.FormatWallClock(dbMessage.DisplayFrom, getMessageLocation(dbMessage)),
+			DateRangeTo:   schedule.FormatWallClock(dbMessage.DisplayTo, getMessageLocation(dbMessage)),
+			Timezone:      getMessageLocation(dbMessage).String(),
 			Type:          dbMessage.Type,
-			Language:      dbMessage.Language,
 			Websites:      websites,
+			Translations:  translations,
+			Paths:         paths,
+			Slots:         slots,
+
+			RecurrenceRule:       dbMessage.RecurrenceRule,
+			RecurrenceExceptions: dbMessage.RecurrenceExceptions,
+			DailyFrom:            dbMessage.DailyFrom,
+			DailyTo:              dbMessage.DailyTo,
+			Weekdays:             getMessageWeekdayCodes(dbMessage),
 		},
-		FormSettings: getBaseMessageFormSettings(kit.Request.Context()),
-		FormErrors:   v.Errors{},
+		FormSettings:  getBaseMessageFormSettings(kit.Request.Context()),
+		FormErrors:    v.Errors{},
+		Stats:         stats,
+		PreviewTokens: previewTokens,
+	}
+	data.FormSettings.Languages = getMessageFormLanguages(translations)
+	if dbMessage.RecurrenceDuration > 0 {
+		data.FormValues.RecurrenceDuration = schedule.FormatDuration(time.Duration(dbMessage.RecurrenceDuration) * time.Minute)
 	}
 
 	return kit.Render(messages.PageMessageEdit(data))
@@ -96,11 +155,17 @@ func HandleMessageGet(kit *kit.Kit) error {
 var createMessageSchema = v.Schema{
 	"dateRangeFrom": v.Rules(v.Required),
 	"dateRangeTo":   v.Rules(v.Required),
-	"message":       v.Rules(v.Required),
-	"title":         v.Rules(v.Required),
 	"type":          v.Rules(v.Required, v.In([]string{"info", "warning", "danger"})),
-	"language":      v.Rules(v.Required, v.In([]string{"en", "fr"})),
 	"websites":      v.Rules(),
+	"timezone":      v.Rules(v.Required),
+	// The recurrence is validated by parseMessageRecurrence.
+	"recurrenceRule":       v.Rules(),
+	"recurrenceDuration":   v.Rules(),
+	"recurrenceExceptions": v.Rules(),
+	// The daily window is validated by parseMessageDailyWindow.
+	"dailyFrom": v.Rules(),
+	"dailyTo":   v.Rules(),
+	"weekdays":  v.Rules(),
 }
 
 func HandleMessageCreate(kit *kit.Kit) error {
@@ -109,7 +174,11 @@ func HandleMessageCreate(kit *kit.Kit) error {
 	formSettings := getBaseMessageFormSettings(kit.Request.Context())
 
 	errors, ok := v.Request(kit.Request, formValues, createMessageSchema)
-	if !ok {
+	var translationsOk, pathsOk, slotsOk bool
+	formValues.Translations, translationsOk = parseMessageTranslations(kit.Request, nil, errors)
+	formValues.Paths, pathsOk = parseMessagePaths(kit.Request, getWebsiteOptionIds(formSettings), errors)
+	formValues.Slots, slotsOk = parseMessageSlots(kit.Request, formSettings.WebsiteSlots, errors)
+	if !ok || !translationsOk || !pathsOk || !slotsOk {
 		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
 	}
 
@@ -118,40 +187,59 @@ func HandleMessageCreate(kit *kit.Kit) error {
 		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
 	}
 
-	displayFrom, err := processTimeWithTimezone(formValues.DateRangeFrom)
-	if err != nil {
-		errors.Add("form", "Failed to parse date range from")
+	displayFrom, displayTo, ok := parseMessageSchedule(formValues, errors)
+	if !ok {
 		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
 	}
-
-	displayTo, err := processTimeWithTimezone(formValues.DateRangeTo)
-	if err != nil {
-		errors.Add("form", "Failed to parse date range to")
+	recurrence, ok := parseMessageRecurrence(formValues, errors)
+	if !ok {
+		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
+	}
+	recurrenceRule, recurrenceExceptions, recurrenceDuration := getMessageRecurrenceColumns(recurrence)
+	dailyWindow, ok := parseMessageDailyWindow(formValues, errors)
+	if !ok {
 		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
 	}
 
 	dbMessage := &models.Message{
 		DisplayFrom: displayFrom,
 		DisplayTo:   displayTo,
-		Message:     formValues.Message,
-		Title:       formValues.Title,
+		Timezone:    formValues.Timezone,
 		Type:        formValues.Type,
-		Language:    formValues.Language,
 		UserId:      int64(auth.UserID),
+
+		RecurrenceRule:       recurrenceRule,
+		RecurrenceExceptions: recurrenceExceptions,
+		RecurrenceDuration:   recurrenceDuration,
+		DailyFrom:            dailyWindow.from,
+		DailyTo:              dailyWindow.to,
+		Weekdays:             dailyWindow.weekdays,
 	}
 
-	err = dbMessage.Insert(kit.Request.Context(), db.Query, boil.Infer())
+	err := dbMessage.Insert(kit.Request.Context(), db.Query, boil.Infer())
 	if err != nil {
 		errors.Add("form", "Failed to create message")
 		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
 	}
 
-	err = upsertMessageWebsites(kit.Request.Context(), dbMessage.ID, formValues.Websites)
+	err = upsertMessageTranslations(kit.Request.Context(), dbMessage.ID, formValues.Translations)
+	if err != nil {
+		errors.Add("form", "Failed to create message translations")
+		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
+	}
+
+	err = upsertMessageWebsites(kit.Request.Context(), dbMessage.ID, formValues)
 	if err != nil {
 		errors.Add("form", "Failed to create message websites")
 		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
 	}
 
+	websiteIds, err := getMessageWebsiteIds(kit.Request.Context(), dbMessage.ID)
+	if err != nil {
+		return err
+	}
+	invalidateApiMessagesCache(websiteIds...)
+
 	return kit.Redirect(200, "/messages")
 }
 
@@ -166,22 +254,42 @@ func HandleMessageUpdate(kit *kit.Kit) error {
 	}
 	errors, ok := v.Request(kit.Request, formValues, createMessageSchema)
 
+	existingTranslations, err := getMessageTranslationValues(kit.Request.Context(), messageId)
+	if err != nil {
+		return err
+	}
+	var translationsOk bool
+	formValues.Translations, translationsOk = parseMessageTranslations(kit.Request, existingTranslations, errors)
+
 	formSettings := getBaseMessageFormSettings(kit.Request.Context())
+	formSettings.Languages = getMessageFormLanguages(existingTranslations)
+
+	var pathsOk, slotsOk bool
+	formValues.Paths, pathsOk = parseMessagePaths(kit.Request, getWebsiteOptionIds(formSettings), errors)
+	formValues.Slots, slotsOk = parseMessageSlots(kit.Request, formSettings.WebsiteSlots, errors)
 
 	err = parseMultiSelectFields(kit.Request, formValues)
-	if err != nil || !ok {
+	if err != nil || !ok || !translationsOk || !pathsOk || !slotsOk {
 		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
 	}
 
-	displayFrom, err := processTimeWithTimezone(formValues.DateRangeFrom)
-	if err != nil {
-		errors.Add("form", "Failed to parse date range from")
+	displayFrom, displayTo, ok := parseMessageSchedule(formValues, errors)
+	if !ok {
+		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
+	}
+	recurrence, ok := parseMessageRecurrence(formValues, errors)
+	if !ok {
+		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
+	}
+	recurrenceRule, recurrenceExceptions, recurrenceDuration := getMessageRecurrenceColumns(recurrence)
+	dailyWindow, ok := parseMessageDailyWindow(formValues, errors)
+	if !ok {
 		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
 	}
 
-	displayTo, err := processTimeWithTimezone(formValues.DateRangeTo)
+	previousWebsiteIds, err := getMessageWebsiteIds(kit.Request.Context(), messageId)
 	if err != nil {
-		errors.Add("form", "Failed to parse date range to")
+		errors.Add("form", "Failed to update message")
 		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
 	}
 
@@ -190,38 +298,82 @@ func HandleMessageUpdate(kit *kit.Kit) error {
 	).UpdateAll(kit.Request.Context(), db.Query, models.M{
 		models.MessageColumns.DisplayFrom: displayFrom,
 		models.MessageColumns.DisplayTo:   displayTo,
-		models.MessageColumns.Message:     formValues.Message,
-		models.MessageColumns.Title:       formValues.Title,
+		models.MessageColumns.Timezone:    formValues.Timezone,
 		models.MessageColumns.Type:        formValues.Type,
-		models.MessageColumns.Language:    formValues.Language,
+		models.MessageColumns.UpdatedAt:   time.Now().UTC(),
+
+		models.MessageColumns.RecurrenceRule:       recurrenceRule,
+		models.MessageColumns.RecurrenceExceptions: recurrenceExceptions,
+		models.MessageColumns.RecurrenceDuration:   recurrenceDuration,
+		models.MessageColumns.DailyFrom:            dailyWindow.from,
+		models.MessageColumns.DailyTo:              dailyWindow.to,
+		models.MessageColumns.Weekdays:             dailyWindow.weekdays,
 	})
 	if err != nil {
 		errors.Add("form", "Failed to update message")
 		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
 	}
 
-	err = upsertMessageWebsites(kit.Request.Context(), messageId, formValues.Websites)
+	// Every edit is a new revision, so clients display the message again
+	// even when it was dismissed.
+	_, err = db.Query.ExecContext(kit.Request.Context(),
+		"UPDATE messages SET revision = revision + 1 WHERE id = ?",
+		messageId,
+	)
+	if err != nil {
+		errors.Add("form", "Failed to update message")
+		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
+	}
+
+	err = upsertMessageTranslations(kit.Request.Context(), messageId, formValues.Translations)
+	if err != nil {
+		errors.Add("form", "Failed to update message translations")
+		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
+	}
+
+	err = upsertMessageWebsites(kit.Request.Context(), messageId, formValues)
 	if err != nil {
 		errors.Add("form", "Failed to update message websites")
 		return kit.Render(messages.MessageForm(formValues, formSettings, errors))
 	}
 
+	websiteIds, err := getMessageWebsiteIds(kit.Request.Context(), messageId)
+	if err != nil {
+		return err
+	}
+	invalidateApiMessagesCache(append(previousWebsiteIds, websiteIds...)...)
+
 	return kit.Redirect(200, "/messages")
 }
 
-func processTimeWithTimezone(stringValue string) (time.Time, error) {
-	loc, _ := time.LoadLocation(kit.Getenv("TIMEZONE", "America/Toronto"))
+// parseMessageSchedule returns the instants, in UTC, of the date range of the
+// message form, read on the wall clock of its timezone. Validation errors are
+// added to errors.
+func parseMessageSchedule(formValues *messages.MessageFormValues, errors v.Errors) (time.Time, time.Time, bool) {
+	loc, err := schedule.LoadLocation(formValues.Timezone)
+	if err != nil {
+		errors.Add("timezone", err.Error())
+		return time.Time{}, time.Time{}, false
+	}
+
+	displayFrom, err := schedule.ParseWallClock(formValues.DateRangeFrom, loc)
+	if err != nil {
+		errors.Add("dateRangeFrom", err.Error())
+		return time.Time{}, time.Time{}, false
+	}
 
-	parsedDate, err := time.Parse(time.RFC3339, stringValue)
+	displayTo, err := schedule.ParseWallClock(formValues.DateRangeTo, loc)
 	if err != nil {
-		return time.Time{}, errors.New("Failed to parse date range from")
+		errors.Add("dateRangeTo", err.Error())
+		return time.Time{}, time.Time{}, false
 	}
 
-	return time.Date(parsedDate.Year(), parsedDate.Month(), parsedDate.Day(),
-		parsedDate.Hour(), parsedDate.Minute(), parsedDate.Second(), parsedDate.Nanosecond(), loc), nil
+	return displayFrom, displayTo, true
 }
 
-func upsertMessageWebsites(ctx context.Context, messageId int64, websites []string) error {
+// upsertMessageWebsites replaces the websites of a message, with its slot and
+// page targeting on each of them.
+func upsertMessageWebsites(ctx context.Context, messageId int64, formValues *messages.MessageFormValues) error {
 	_, err := models.WebsitesMessages(
 		models.WebsitesMessageWhere.MessageId.EQ(messageId),
 	).DeleteAll(ctx, db.Query)
@@ -229,7 +381,7 @@ func upsertMessageWebsites(ctx context.Context, messageId int64, websites []stri
 		return err
 	}
 
-	for _, websiteId := range websites {
+	for _, websiteId := range formValues.Websites {
 		websiteIdInt, err := strconv.ParseInt(websiteId, 10, 64)
 		if err != nil {
 			return err
@@ -239,6 +391,11 @@ func upsertMessageWebsites(ctx context.Context, messageId int64, websites []stri
 			WebsiteId: websiteIdInt,
 			MessageId: messageId,
 		}
+		if websitePaths, ok := formValues.Paths[websiteId]; ok {
+			websiteMessage.IncludePaths = websitePaths.Include
+			websiteMessage.ExcludePaths = websitePaths.Exclude
+		}
+		websiteMessage.Slot = formValues.Slots[websiteId]
 
 		err = websiteMessage.Insert(ctx, db.Query, boil.Infer())
 		if err != nil {
@@ -255,6 +412,18 @@ func HandleMessageDelete(kit *kit.Kit) error {
 		return helpers.RenderNoticeError(kit, err)
 	}
 
+	websiteIds, err := getMessageWebsiteIds(kit.Request.Context(), messageId)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	_, err = models.MessageTranslations(
+		models.MessageTranslationWhere.MessageID.EQ(messageId),
+	).DeleteAll(kit.Request.Context(), db.Query)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
 	_, err = models.Messages(
 		models.MessageWhere.ID.EQ(messageId),
 	).DeleteAll(kit.Request.Context(), db.Query)
@@ -269,14 +438,38 @@ func HandleMessageDelete(kit *kit.Kit) error {
 		return helpers.RenderNoticeError(kit, err)
 	}
 
+	_, err = models.MessageStats(
+		models.MessageStatWhere.MessageID.EQ(messageId),
+	).DeleteAll(kit.Request.Context(), db.Query)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	_, err = models.MessageLinkClicks(
+		models.MessageLinkClickWhere.MessageID.EQ(messageId),
+	).DeleteAll(kit.Request.Context(), db.Query)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	_, err = models.PreviewTokens(
+		models.PreviewTokenWhere.MessageID.EQ(messageId),
+	).DeleteAll(kit.Request.Context(), db.Query)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	invalidateApiMessagesCache(websiteIds...)
+
 	return kit.Redirect(200, "/messages")
 }
 
 func getBaseMessageFormSettings(ctx context.Context) *messages.MessageFormSettings {
-	loc, _ := time.LoadLocation(kit.Getenv("TIMEZONE", "UTC"))
 	settings := &messages.MessageFormSettings{
-		DateMin: time.Now().In(loc),
-		DateMax: time.Now().In(loc).AddDate(1, 0, 0),
+		DateMin:   time.Now().UTC(),
+		DateMax:   time.Now().UTC().AddDate(1, 0, 0),
+		Languages: getMessageFormLanguages(nil),
+		Timezones: schedule.Timezones,
 	}
 
 	dbWebsitesList, err := models.Websites().All(ctx, db.Query)
@@ -288,20 +481,84 @@ func getBaseMessageFormSettings(ctx context.Context) *messages.MessageFormSettin
 	for _, website := range dbWebsitesList {
 		settings.Websites[fmt.Sprintf("%d", website.ID)] = fmt.Sprintf("%s (%s)", website.Name, website.URL)
 	}
+	settings.WebsiteSlots = getWebsiteSlotOptions(ctx)
 
 	return settings
 }
 
-func getMessageStatus(ctx context.Context, message *models.Message) string {
-	loc, _ := time.LoadLocation(kit.Getenv("TIMEZONE", "UTC"))
-	now := time.Now().In(loc)
+// getMessageListItem describes a message for the list, titled in the language
+// of the admin UI when translated in it, with its dates in loc.
+func getMessageListItem(ctx context.Context, dbMessage *models.Message, uiLanguage string, loc *time.Location) *messages.MessageListItem {
+	websites := make([]*models.Website, 0, len(dbMessage.R.MessageIdWebsitesMessages))
+	for _, link := range dbMessage.R.MessageIdWebsitesMessages {
+		if link.R != nil && link.R.WebsiteIdWebsite != nil {
+			websites = append(websites, link.R.WebsiteIdWebsite)
+		}
+	}
+
+	item := &messages.MessageListItem{
+		ID:               dbMessage.ID,
+		DisplayFrom:      dbMessage.DisplayFrom.In(loc),
+		DisplayTo:        dbMessage.DisplayTo.In(loc),
+		Type:             dbMessage.Type,
+		Status:           getMessageStatus(ctx, dbMessage, websites, loc),
+		NextOccurrence:   getMessageNextOccurrence(dbMessage, websites, time.Now()).In(loc),
+		Languages:        make([]string, 0, len(dbMessage.R.MessageTranslations)),
+		MissingLanguages: make([]string, 0),
+	}
+
+	for _, translation := range dbMessage.R.MessageTranslations {
+		item.Languages = append(item.Languages, translation.Language)
+		if item.Title == "" || translation.Language == uiLanguage {
+			item.Title = translation.Title
+		}
+	}
+
+	for _, language := range languageList.all() {
+		if language.Enabled && findTranslation(dbMessage.R.MessageTranslations, language.Code) == nil {
+			item.MissingLanguages = append(item.MissingLanguages, language.Code)
+		}
+	}
+
+	return item
+}
+
+// getMessageStatus returns whether a message is displayed on any of its
+// websites. A recurring message is scheduled between its occurrences, and
+// expired after the last. A message outside of its daily window is hidden
+// until the next one starts, shown in loc.
+func getMessageStatus(ctx context.Context, message *models.Message, websites []*models.Website, loc *time.Location) string {
+	now := time.Now()
+	var hiddenUntil time.Time
+	hidden, upcoming := false, false
+	for _, messageSchedule := range getMessageSchedules(message, websites) {
+		if _, active := messageSchedule.Active(now); active {
+			return i18n.T(ctx, fmt.Sprintf("messages.status.%s", types.MessagesActiveEnum))
+		}
+		if until, ok := messageSchedule.HiddenUntil(now); ok {
+			hidden = true
+			if !until.IsZero() && (hiddenUntil.IsZero() || until.Before(hiddenUntil)) {
+				hiddenUntil = until
+			}
+		}
+		if !messageSchedule.NextBoundary(now).IsZero() {
+			upcoming = true
+		}
+	}
+
 	switch {
-	case message.DisplayFrom.After(now):
+	case hidden && !hiddenUntil.IsZero():
+		layout := "2006-01-02 15:04"
+		if schedule.DayOf(hiddenUntil.In(loc)) == schedule.DayOf(now.In(loc)) {
+			layout = "15:04"
+		}
+		return i18n.T(ctx, "messages.status.hidden_until", hiddenUntil.In(loc).Format(layout))
+	case hidden:
+		return i18n.T(ctx, "messages.status.hidden")
+	case upcoming:
 		return i18n.T(ctx, fmt.Sprintf("messages.status.%s", types.MessagesScheduledEnum))
-	case message.DisplayTo.Before(now):
-		return i18n.T(ctx, fmt.Sprintf("messages.status.%s", types.MessagesExpiredEnum))
 	default:
-		return i18n.T(ctx, fmt.Sprintf("messages.status.%s", types.MessagesActiveEnum))
+		return i18n.T(ctx, fmt.Sprintf("messages.status.%s", types.MessagesExpiredEnum))
 	}
 }
 
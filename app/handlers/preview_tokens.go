@@ -0,0 +1,362 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"messages/app/db"
+	"messages/app/helpers"
+	"messages/app/models"
+	"messages/app/schedule"
+	"messages/app/views/messages"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/anthdm/superkit/kit"
+	v "github.com/anthdm/superkit/validate"
+	"github.com/go-chi/chi/v5"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// previewTokenLifetimes maps the lifetimes offered in the admin UI to their
+// duration.
+var previewTokenLifetimes = map[string]time.Duration{
+	"hour":  time.Hour,
+	"day":   24 * time.Hour,
+	"week":  7 * 24 * time.Hour,
+	"month": 30 * 24 * time.Hour,
+}
+
+// previewAtLayout is the layout of datetime-local inputs.
+const previewAtLayout = "2006-01-02T15:04"
+
+// previewQueryParam is the query parameter of the page URLs passing a preview
+// token to the widget.
+const previewQueryParam = "messages_preview"
+
+// apiPreview is what a valid preview token shows: the messages of the website
+// as displayed at an instant, or only one of them.
+type apiPreview struct {
+	at time.Time
+	// messageId restricts the preview to a message, when set.
+	messageId int64
+	// trackLinks renders the links as the API serves them. Simulations set
+	// it, while the clicks of editors on previewed links are not counted.
+	trackLinks bool
+}
+
+// filter keeps the messages shown by the preview.
+func (p *apiPreview) filter(messages []Message) []Message {
+	if p.messageId == 0 {
+		return messages
+	}
+	filtered := make([]Message, 0, 1)
+	for _, message := range messages {
+		if message.ID == p.messageId {
+			filtered = append(filtered, message)
+		}
+	}
+	return filtered
+}
+
+// parseApiPreview validates the preview token of a request, if any, against
+// the website of the request.
+func parseApiPreview(request *http.Request, apiReq *apiRequest) *apiError {
+	token := helpers.GetPreviewToken(request)
+	if token == "" {
+		return nil
+	}
+
+	preview, err := findPreviewByToken(request.Context(), token, apiReq.website, time.Now().UTC())
+	if err != nil {
+		return &apiError{status: 403, message: "Invalid preview token"}
+	}
+	apiReq.preview = preview
+	return nil
+}
+
+// findPreviewByToken returns the preview of an active token. The signature of
+// the token is checked before it is looked up, and its scope must match the
+// one stored. Message tokens show their message on each of its websites, and
+// website tokens their whole website.
+func findPreviewByToken(ctx context.Context, token string, website *models.Website, now time.Time) (*apiPreview, error) {
+	scope, ok := helpers.ParsePreviewToken(getSigningSecret(), token)
+	if !ok {
+		return nil, errors.New("invalid preview token signature")
+	}
+	if !scope.ExpiresAt.After(now) {
+		return nil, errors.New("expired preview token")
+	}
+	if scope.WebsiteId != 0 && scope.WebsiteId != website.ID {
+		return nil, errors.New("preview token of another website")
+	}
+
+	dbToken, err := models.PreviewTokens(
+		models.PreviewTokenWhere.TokenHash.EQ(helpers.HashAPIKey(token)),
+		models.PreviewTokenWhere.RevokedAt.IsNull(),
+		models.PreviewTokenWhere.ExpiresAt.GT(now),
+		qm.Load(models.PreviewTokenRels.Message),
+	).One(ctx, db.Query)
+	if err != nil {
+		return nil, err
+	}
+	if dbToken.R.Message == nil {
+		return nil, errors.New("message not found")
+	}
+	if dbToken.MessageID != scope.MessageId || dbToken.WebsiteID.Int64 != scope.WebsiteId || !dbToken.ExpiresAt.Equal(scope.ExpiresAt) {
+		return nil, errors.New("preview token scope mismatch")
+	}
+
+	preview := &apiPreview{}
+	if dbToken.WebsiteID.Valid {
+		preview.at = dbToken.PreviewAt.Time
+	} else {
+		if !messageTargetsWebsite(ctx, dbToken.MessageID, website.ID) {
+			return nil, errors.New("preview token of a message of another website")
+		}
+		preview.at = getMessagePreviewInstant(dbToken.R.Message, website, now)
+		preview.messageId = dbToken.MessageID
+	}
+
+	if !dbToken.LastUsedAt.Valid || now.Sub(dbToken.LastUsedAt.Time) > apiKeyUsageResolution {
+		_, err = models.PreviewTokens(
+			models.PreviewTokenWhere.ID.EQ(dbToken.ID),
+		).UpdateAll(ctx, db.Query, models.M{
+			models.PreviewTokenColumns.LastUsedAt: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return preview, nil
+}
+
+// getMessagePreviewInstant returns the instant showing a message as it is or
+// will be displayed: now while it is displayed, otherwise the start of its
+// next display on a website, or of its first one once it is expired.
+func getMessagePreviewInstant(message *models.Message, website *models.Website, now time.Time) time.Time {
+	messageSchedule := getMessageSchedule(message, website)
+	if _, active := messageSchedule.Active(now); active {
+		return now
+	}
+	for _, after := range []time.Time{now, message.DisplayFrom} {
+		if upcoming := messageSchedule.Upcoming(after, 1); len(upcoming) > 0 {
+			return upcoming[0].Start
+		}
+	}
+	return message.DisplayFrom
+}
+
+var createPreviewTokenSchema = v.Schema{
+	"websiteId": v.Rules(),
+	"previewAt": v.Rules(),
+	"expiresIn": v.Rules(v.Required, v.In(messages.PreviewTokenLifetimes)),
+}
+
+func HandleMessagePreviewTokenCreate(kit *kit.Kit) error {
+	messageId, err := helpers.GetIdFromUrl(kit)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	formValues := &messages.PreviewTokenFormValues{}
+	if errors, ok := v.Request(kit.Request, formValues, createPreviewTokenSchema); !ok {
+		return renderPreviewTokensSection(kit, messageId, formValues, "", errors)
+	}
+
+	errors := v.Errors{}
+	dbMessage, err := models.FindMessage(kit.Request.Context(), db.Query, messageId)
+	if err != nil {
+		errors.Add("form", "Message not found")
+		return renderPreviewTokensSection(kit, messageId, formValues, "", errors)
+	}
+
+	// Tokens are signed with their expiry, to the second.
+	now := time.Now().UTC()
+	dbToken := &models.PreviewToken{
+		MessageID: messageId,
+		ExpiresAt: now.Add(previewTokenLifetimes[formValues.ExpiresIn]).Truncate(time.Second),
+	}
+
+	// A website token previews the whole website at an instant, by default
+	// the one showing the message.
+	if formValues.WebsiteId != "" {
+		websiteId, err := strconv.ParseInt(formValues.WebsiteId, 10, 64)
+		if err != nil || !messageTargetsWebsite(kit.Request.Context(), messageId, websiteId) {
+			errors.Add("websiteId", "must be one of the websites of the message")
+			return renderPreviewTokensSection(kit, messageId, formValues, "", errors)
+		}
+
+		website, err := models.FindWebsite(kit.Request.Context(), db.Query, websiteId)
+		if err != nil {
+			errors.Add("form", "Failed to load the website")
+			return renderPreviewTokensSection(kit, messageId, formValues, "", errors)
+		}
+		previewAt := getMessagePreviewInstant(dbMessage, website, now)
+		if formValues.PreviewAt != "" {
+			previewAt, err = schedule.ParseWallClock(formValues.PreviewAt, getUserLocation(kit))
+			if err != nil {
+				errors.Add("previewAt", "must be a date and a time")
+				return renderPreviewTokensSection(kit, messageId, formValues, "", errors)
+			}
+		}
+		if previewAt.Before(now) {
+			errors.Add("previewAt", "must not be in the past")
+			return renderPreviewTokensSection(kit, messageId, formValues, "", errors)
+		}
+
+		dbToken.WebsiteID = null.Int64From(websiteId)
+		dbToken.PreviewAt = null.TimeFrom(previewAt)
+	}
+
+	token, prefix, hash, err := helpers.GeneratePreviewToken(getSigningSecret(), &helpers.PreviewScope{
+		MessageId: messageId,
+		WebsiteId: dbToken.WebsiteID.Int64,
+		ExpiresAt: dbToken.ExpiresAt,
+	})
+	if err != nil {
+		errors.Add("form", "Failed to generate preview token")
+		return renderPreviewTokensSection(kit, messageId, formValues, "", errors)
+	}
+	dbToken.TokenPrefix = prefix
+	dbToken.TokenHash = hash
+
+	if err := dbToken.Insert(kit.Request.Context(), db.Query, boil.Infer()); err != nil {
+		errors.Add("form", "Failed to create preview token")
+		return renderPreviewTokensSection(kit, messageId, formValues, "", errors)
+	}
+
+	return renderPreviewTokensSection(kit, messageId, formValues, token, errors)
+}
+
+func HandleMessagePreviewTokenRevoke(kit *kit.Kit) error {
+	messageId, err := helpers.GetIdFromUrl(kit)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
+	formValues := &messages.PreviewTokenFormValues{}
+	errors := v.Errors{}
+
+	tokenId, err := strconv.ParseInt(chi.URLParam(kit.Request, "tokenId"), 10, 64)
+	if err != nil {
+		errors.Add("form", "Invalid preview token ID")
+		return renderPreviewTokensSection(kit, messageId, formValues, "", errors)
+	}
+
+	if _, err := models.PreviewTokens(
+		models.PreviewTokenWhere.ID.EQ(tokenId),
+		models.PreviewTokenWhere.MessageID.EQ(messageId),
+		models.PreviewTokenWhere.RevokedAt.IsNull(),
+	).UpdateAll(kit.Request.Context(), db.Query, models.M{
+		models.PreviewTokenColumns.RevokedAt: time.Now().UTC(),
+	}); err != nil {
+		errors.Add("form", "Failed to revoke preview token")
+		return renderPreviewTokensSection(kit, messageId, formValues, "", errors)
+	}
+
+	return renderPreviewTokensSection(kit, messageId, formValues, "", errors)
+}
+
+// messageTargetsWebsite reports whether a message is displayed on a website.
+func messageTargetsWebsite(ctx context.Context, messageId int64, websiteId int64) bool {
+	targeted, err := models.WebsitesMessages(
+		models.WebsitesMessageWhere.MessageId.EQ(messageId),
+		models.WebsitesMessageWhere.WebsiteId.EQ(websiteId),
+	).Exists(ctx, db.Query)
+	return err == nil && targeted
+}
+
+func renderPreviewTokensSection(kit *kit.Kit, messageId int64, formValues *messages.PreviewTokenFormValues, revealedToken string, errors v.Errors) error {
+	data, err := getPreviewTokensSectionData(kit.Request.Context(), messageId, getUserLocation(kit))
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+	if formValues.ExpiresIn != "" {
+		data.FormValues = formValues
+	}
+	data.FormErrors = errors
+	if revealedToken != "" {
+		data.RevealedToken = revealedToken
+		data.RevealedLinks = getPreviewLinks(kit.Request.Context(), messageId, formValues.WebsiteId, revealedToken)
+	}
+
+	return kit.Render(messages.PreviewTokensSection(data))
+}
+
+// getPreviewLinks returns the home pages of the websites of a message, or of
+// one of them, passing a preview token to the widget.
+func getPreviewLinks(ctx context.Context, messageId int64, websiteId string, token string) []string {
+	dbWebsites, err := models.Websites(
+		qm.InnerJoin("websites_messages ON "+models.WebsitesMessageTableColumns.WebsiteId+" = "+models.WebsiteTableColumns.ID),
+		models.WebsitesMessageWhere.MessageId.EQ(messageId),
+		qm.OrderBy("websites.id ASC"),
+	).All(ctx, db.Query)
+	if err != nil {
+		return nil
+	}
+
+	links := make([]string, 0, len(dbWebsites))
+	for _, dbWebsite := range dbWebsites {
+		if websiteId != "" && websiteId != fmt.Sprintf("%d", dbWebsite.ID) {
+			continue
+		}
+		links = append(links, fmt.Sprintf("https://%s/?%s=%s", dbWebsite.URL, previewQueryParam, url.QueryEscape(token)))
+	}
+	return links
+}
+
+// getPreviewTokensSectionData lists the preview tokens of a message, with
+// their dates in loc.
+func getPreviewTokensSectionData(ctx context.Context, messageId int64, loc *time.Location) (*messages.PreviewTokensSectionData, error) {
+	dbTokens, err := models.PreviewTokens(
+		models.PreviewTokenWhere.MessageID.EQ(messageId),
+		qm.Load(models.PreviewTokenRels.Website),
+		qm.OrderBy("created_at DESC"),
+	).All(ctx, db.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*messages.PreviewTokenListItem, 0, len(dbTokens))
+	for _, dbToken := range dbTokens {
+		item := &messages.PreviewTokenListItem{
+			ID:         dbToken.ID,
+			Prefix:     dbToken.TokenPrefix,
+			PreviewAt:  nullTimeToTime(dbToken.PreviewAt).In(loc),
+			CreatedAt:  dbToken.CreatedAt.In(loc),
+			ExpiresAt:  dbToken.ExpiresAt.In(loc),
+			LastUsedAt: nullTimeToTime(dbToken.LastUsedAt).In(loc),
+			RevokedAt:  nullTimeToTime(dbToken.RevokedAt).In(loc),
+		}
+		if dbToken.R.Website != nil {
+			item.WebsiteName = dbToken.R.Website.Name
+		}
+		tokens = append(tokens, item)
+	}
+
+	dbWebsites, err := models.Websites(
+		qm.InnerJoin("websites_messages ON "+models.WebsitesMessageTableColumns.WebsiteId+" = "+models.WebsiteTableColumns.ID),
+		models.WebsitesMessageWhere.MessageId.EQ(messageId),
+	).All(ctx, db.Query)
+	if err != nil {
+		return nil, err
+	}
+	websites := make(map[string]string, len(dbWebsites))
+	for _, dbWebsite := range dbWebsites {
+		websites[fmt.Sprintf("%d", dbWebsite.ID)] = dbWebsite.Name
+	}
+
+	return &messages.PreviewTokensSectionData{
+		MessageID:  messageId,
+		Tokens:     tokens,
+		Websites:   websites,
+		FormValues: &messages.PreviewTokenFormValues{ExpiresIn: "day"},
+		FormErrors: v.Errors{},
+	}, nil
+}
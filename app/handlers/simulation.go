@@ -0,0 +1,323 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"messages/app/db"
+	"messages/app/helpers"
+	"messages/app/models"
+	"messages/app/schedule"
+	"messages/app/views/simulation"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/anthdm/superkit/kit"
+	v "github.com/anthdm/superkit/validate"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// simulationDefaultDays and simulationMaxDays bound the timeline of a
+// simulation.
+const (
+	simulationDefaultDays = 7
+	simulationMaxDays     = 90
+)
+
+// simulationMaxChanges bounds the instants a timeline goes through.
+const simulationMaxChanges = 500
+
+// simulationRequest holds the validated parameters of a simulation. The
+// preview of its API request is set to the instant simulated.
+type simulationRequest struct {
+	apiReq *apiRequest
+	until  time.Time
+}
+
+// simulationResult is the payload of the simulation API: the messages
+// HandleApi serves at an instant, and the instants at which they change.
+type simulationResult struct {
+	Website  int64              `json:"website"`
+	Language string             `json:"language"`
+	At       time.Time          `json:"at"`
+	Until    time.Time          `json:"until"`
+	Messages []Message          `json:"messages"`
+	Timeline []simulationChange `json:"timeline"`
+}
+
+// simulationChange lists the messages served from an instant, along with the
+// ids of the messages added and removed at that instant.
+type simulationChange struct {
+	At       time.Time `json:"at"`
+	Language string    `json:"language"`
+	Messages []Message `json:"messages"`
+	Added    []int64   `json:"added"`
+	Removed  []int64   `json:"removed"`
+}
+
+func HandleSimulation(kit *kit.Kit) error {
+	ctx := kit.Request.Context()
+	loc := getUserLocation(kit)
+	data := &simulation.IndexPageData{
+		FormValues: &simulation.FormValues{
+			At:   time.Now().In(loc).Format(previewAtLayout),
+			Days: strconv.Itoa(simulationDefaultDays),
+		},
+		FormErrors: v.Errors{},
+		Websites:   make(map[string]string),
+		Languages:  make(map[string]string),
+		Timezone:   loc.String(),
+	}
+
+	dbWebsites, err := models.Websites(qm.OrderBy("name ASC")).All(ctx, db.Query)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+	for _, dbWebsite := range dbWebsites {
+		data.Websites[fmt.Sprintf("%d", dbWebsite.ID)] = dbWebsite.Name
+	}
+	for _, language := range languageList.all() {
+		if language.Enabled {
+			data.Languages[language.Code] = language.Name
+		}
+	}
+
+	// The form is submitted with GET, so simulations can be bookmarked.
+	if !kit.Request.URL.Query().Has("website") {
+		return kit.Render(simulation.Index(data))
+	}
+
+	simReq, errors := parseSimulationRequest(kit, data.FormValues)
+	data.FormErrors = errors
+	if errors.Any() {
+		return kit.Render(simulation.Index(data))
+	}
+
+	result, err := simulateMessages(ctx, simReq)
+	if err != nil {
+		data.FormErrors.Add("form", "Failed to simulate the messages")
+		return kit.Render(simulation.Index(data))
+	}
+	data.Result = getSimulationResultView(result, loc)
+
+	return kit.Render(simulation.Index(data))
+}
+
+// HandleSimulationApi returns the simulation of the query parameters of the
+// simulation page as JSON.
+func HandleSimulationApi(kit *kit.Kit) error {
+	kit.Response.Header().Set("Content-Type", "application/json")
+
+	simReq, errors := parseSimulationRequest(kit, &simulation.FormValues{})
+	if errors.Any() {
+		return kit.JSON(400, map[string]v.Errors{"errors": errors})
+	}
+
+	result, err := simulateMessages(kit.Request.Context(), simReq)
+	if err != nil {
+		return err
+	}
+	return kit.JSON(200, result)
+}
+
+// parseSimulationRequest validates the query parameters of a simulation and
+// copies them to formValues. The instant defaults to now, and accepts RFC 3339
+// dates as well as the dates of datetime-local inputs, read in the timezone of
+// the user.
+func parseSimulationRequest(kit *kit.Kit, formValues *simulation.FormValues) (*simulationRequest, v.Errors) {
+	query := kit.Request.URL.Query()
+	errors := v.Errors{}
+	loc := getUserLocation(kit)
+	now := time.Now().UTC()
+
+	formValues.WebsiteId = query.Get("website")
+	formValues.Language = query.Get("lang")
+	formValues.Path = query.Get("path")
+	if query.Has("at") {
+		formValues.At = query.Get("at")
+	}
+	if query.Has("days") {
+		formValues.Days = query.Get("days")
+	}
+
+	var website *models.Website
+	websiteId, err := strconv.ParseInt(formValues.WebsiteId, 10, 64)
+	if err == nil {
+		website, err = models.FindWebsite(kit.Request.Context(), db.Query, websiteId)
+	}
+	if err != nil {
+		errors.Add("website", "must be an existing website")
+	}
+
+	if !IsValidLanguage(formValues.Language) {
+		errors.Add("lang", "must be an enabled language")
+	}
+
+	at := now
+	if formValues.At != "" {
+		at, err = time.Parse(time.RFC3339, formValues.At)
+		if err != nil {
+			at, err = schedule.ParseWallClock(formValues.At, loc)
+		}
+		if err != nil {
+			errors.Add("at", "must be a date and a time")
+		}
+	}
+
+	days := simulationDefaultDays
+	if formValues.Days != "" {
+		days, err = strconv.Atoi(formValues.Days)
+		if err != nil || days < 0 || days > simulationMaxDays {
+			errors.Add("days", fmt.Sprintf("must be a number of days between 0 and %d", simulationMaxDays))
+		}
+	}
+
+	var path string
+	if formValues.Path != "" {
+		var ok bool
+		if path, ok = helpers.NormalizePath(formValues.Path); !ok {
+			errors.Add("path", "must start with /")
+		}
+	}
+
+	if errors.Any() {
+		return nil, errors
+	}
+
+	// The request is built as parseApiRequest does, so the simulated messages
+	// are rendered as the API serves them, tracked links included.
+	preferredLanguages := []string{formValues.Language}
+	return &simulationRequest{
+		apiReq: &apiRequest{
+			languages:          languageChain(preferredLanguages, website.FallbackLanguage),
+			preferredLanguages: preferredLanguages,
+			path:               path,
+			website:            website,
+			preview:            &apiPreview{at: at, trackLinks: true},
+		},
+		until: at.AddDate(0, 0, days),
+	}, errors
+}
+
+// simulateMessages returns the messages HandleApi serves for a request at the
+// instant of its preview, and every change of those messages until the end of
+// the simulation. The messages only change at the boundaries of the display
+// periods of the messages of the website, which the timeline walks through.
+func simulateMessages(ctx context.Context, simReq *simulationRequest) (*simulationResult, error) {
+	apiReq := simReq.apiReq
+	at := apiReq.preview.at
+	entry, messages, lang, err := getNegotiatedMessagesEntry(ctx, apiReq, at)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &simulationResult{
+		Website:  apiReq.website.ID,
+		Language: lang,
+		At:       at.UTC(),
+		Until:    simReq.until.UTC(),
+		Messages: messages,
+		Timeline: make([]simulationChange, 0),
+	}
+
+	previous, previousLang := messages, lang
+	next := entry.nextBoundary
+	for i := 0; i < simulationMaxChanges && !next.IsZero() && !next.After(simReq.until); i++ {
+		apiReq.preview.at = next
+		entry, messages, lang, err = getNegotiatedMessagesEntry(ctx, apiReq, next)
+		if err != nil {
+			return nil, err
+		}
+
+		added, removed := diffMessages(previous, messages)
+		if len(added) > 0 || len(removed) > 0 || lang != previousLang {
+			result.Timeline = append(result.Timeline, simulationChange{
+				At:       next.UTC(),
+				Language: lang,
+				Messages: messages,
+				Added:    added,
+				Removed:  removed,
+			})
+		}
+		previous, previousLang = messages, lang
+		next = entry.nextBoundary
+	}
+
+	return result, nil
+}
+
+// diffMessages returns the ids of the messages of current missing from
+// previous, and the other way around.
+func diffMessages(previous []Message, current []Message) ([]int64, []int64) {
+	previousIds := make([]int64, 0, len(previous))
+	for _, message := range previous {
+		previousIds = append(previousIds, message.ID)
+	}
+	currentIds := make([]int64, 0, len(current))
+	for _, message := range current {
+		currentIds = append(currentIds, message.ID)
+	}
+
+	added := make([]int64, 0)
+	for _, id := range currentIds {
+		if !slices.Contains(previousIds, id) {
+			added = append(added, id)
+		}
+	}
+	removed := make([]int64, 0)
+	for _, id := range previousIds {
+		if !slices.Contains(currentIds, id) {
+			removed = append(removed, id)
+		}
+	}
+	return added, removed
+}
+
+// getSimulationResultView converts a simulation to the simulation page, with
+// its dates in loc.
+func getSimulationResultView(result *simulationResult, loc *time.Location) *simulation.Result {
+	seen := make(map[int64]*simulation.Message)
+	toView := func(messages []Message) []*simulation.Message {
+		views := make([]*simulation.Message, 0, len(messages))
+		for _, message := range messages {
+			view := &simulation.Message{
+				ID:          message.ID,
+				Title:       message.Title,
+				Type:        message.Type,
+				Slot:        message.Slot,
+				DisplayFrom: message.DisplayFrom.In(loc),
+				DisplayTo:   message.DisplayTo.In(loc),
+			}
+			seen[message.ID] = view
+			views = append(views, view)
+		}
+		return views
+	}
+	byIds := func(ids []int64) []*simulation.Message {
+		views := make([]*simulation.Message, 0, len(ids))
+		for _, id := range ids {
+			views = append(views, seen[id])
+		}
+		return views
+	}
+
+	view := &simulation.Result{
+		At:       result.At.In(loc),
+		Until:    result.Until.In(loc),
+		Language: result.Language,
+		Messages: toView(result.Messages),
+		Timeline: make([]*simulation.Change, 0, len(result.Timeline)),
+	}
+	for _, change := range result.Timeline {
+		// Added messages are in the new set, removed ones in a previous one.
+		toView(change.Messages)
+		view.Timeline = append(view.Timeline, &simulation.Change{
+			At:       change.At.In(loc),
+			Language: change.Language,
+			Added:    byIds(change.Added),
+			Removed:  byIds(change.Removed),
+			Count:    len(change.Messages),
+		})
+	}
+	return view
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"messages/app/db"
+	"messages/app/models"
+	"messages/app/schedule"
+	"messages/plugins/auth"
+	"time"
+
+	"github.com/anthdm/superkit/kit"
+)
+
+// getUserLocation returns the timezone the admin UI displays dates in for the
+// authenticated user, the default timezone when the user has none.
+func getUserLocation(kit *kit.Kit) *time.Location {
+	authUser, ok := kit.Auth().(auth.Auth)
+	if !ok {
+		return schedule.DefaultLocation()
+	}
+
+	dbUser, err := models.FindUser(kit.Request.Context(), db.Query, int64(authUser.UserID))
+	if err != nil {
+		return schedule.DefaultLocation()
+	}
+	return schedule.Location(dbUser.Timezone)
+}
+
+// getWebsiteLocation returns the timezone the daily windows of the messages of
+// a website are read in, the default timezone when the website has none.
+func getWebsiteLocation(website *models.Website) *time.Location {
+	return schedule.Location(website.Timezone)
+}
+
+// getMessageLocation returns the timezone the schedule of a message is read
+// in. Messages written before timezones were recorded use the default one.
+func getMessageLocation(message *models.Message) *time.Location {
+	return schedule.Location(message.Timezone)
+}
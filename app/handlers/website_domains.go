@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"messages/app/db"
+	"messages/app/helpers"
+	"messages/app/models"
+	"slices"
+	"strings"
+
+	v "github.com/anthdm/superkit/validate"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// parseDomainPatterns splits a list of domain patterns separated by commas or
+// new lines, lowercased and without duplicates.
+func parseDomainPatterns(list string) []string {
+	patterns := make([]string, 0)
+	for _, pattern := range strings.FieldsFunc(list, isListSeparator) {
+		pattern = strings.ToLower(pattern)
+		if !slices.Contains(patterns, pattern) {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+// validDomainAliases accepts a list of domain patterns, such as domains,
+// wildcards or development origins.
+var validDomainAliases = v.RuleSet{
+	Name: "domainAliases",
+	MessageFunc: func(set v.RuleSet) string {
+		return "must be a list of domains, wildcard patterns or origins (e.g. *.example.com or http://localhost:8080)"
+	},
+	ValidateFunc: func(rule v.RuleSet) bool {
+		str, _ := rule.FieldValue.(string)
+		for _, pattern := range parseDomainPatterns(str) {
+			if !helpers.IsValidDomainPattern(pattern) {
+				return false
+			}
+		}
+		return true
+	},
+}
+
+// getWebsiteDomainPatterns returns the domain patterns of a website: its
+// domain first, then its aliases.
+func getWebsiteDomainPatterns(domain string, aliases string) []string {
+	patterns := []string{domain}
+	for _, pattern := range parseDomainPatterns(aliases) {
+		if pattern != domain {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+// checkDevelopmentPatterns returns an error when a website which is not a
+// staging website claims localhost or an IP address.
+func checkDevelopmentPatterns(patterns []string, staging bool) error {
+	if staging {
+		return nil
+	}
+	for _, pattern := range patterns {
+		if domainPattern, ok := helpers.ParseDomainPattern(pattern); ok && domainPattern.IsDevelopment() {
+			return fmt.Errorf("%s is only allowed for staging websites", pattern)
+		}
+	}
+	return nil
+}
+
+// findDomainConflict returns the reason why the given patterns cannot be
+// claimed by a website, empty when they can. Websites cannot claim patterns
+// matching the same origins, such as *.example.com and shop.example.com, so
+// every origin designates a single website.
+func findDomainConflict(ctx context.Context, websiteId int64, patterns []string) (string, error) {
+	dbDomains, err := models.WebsiteDomains(
+		models.WebsiteDomainWhere.WebsiteID.NEQ(websiteId),
+		qm.Load(models.WebsiteDomainRels.Website),
+		qm.OrderBy("id ASC"),
+	).All(ctx, db.Query)
+	if err != nil {
+		return "", err
+	}
+
+	for _, pattern := range patterns {
+		domainPattern, ok := helpers.ParseDomainPattern(pattern)
+		if !ok {
+			continue
+		}
+		for _, dbDomain := range dbDomains {
+			otherPattern, ok := helpers.ParseDomainPattern(dbDomain.Pattern)
+			if !ok || !domainPattern.Overlaps(otherPattern) {
+				continue
+			}
+
+			websiteName := ""
+			if dbDomain.R.Website != nil {
+				websiteName = dbDomain.R.Website.Name
+			}
+			if dbDomain.Pattern == pattern {
+				return fmt.Sprintf("%s is already used by the website %s", pattern, websiteName), nil
+			}
+			return fmt.Sprintf("%s overlaps %s of the website %s", pattern, dbDomain.Pattern, websiteName), nil
+		}
+	}
+	return "", nil
+}
+
+// getWebsiteDomainAliases returns the domain patterns of a website besides
+// its domain, as listed in the website form.
+func getWebsiteDomainAliases(ctx context.Context, website *models.Website) (string, error) {
+	dbDomains, err := models.WebsiteDomains(
+		models.WebsiteDomainWhere.WebsiteID.EQ(website.ID),
+		models.WebsiteDomainWhere.Pattern.NEQ(website.URL),
+		qm.OrderBy("id ASC"),
+	).All(ctx, db.Query)
+	if err != nil {
+		return "", err
+	}
+
+	aliases := make([]string, 0, len(dbDomains))
+	for _, dbDomain := range dbDomains {
+		aliases = append(aliases, dbDomain.Pattern)
+	}
+	return strings.Join(aliases, "\n"), nil
+}
+
+// upsertWebsiteDomains replaces the domain patterns of a website.
+func upsertWebsiteDomains(ctx context.Context, websiteId int64, patterns []string) error {
+	dbDomains, err := models.WebsiteDomains(
+		models.WebsiteDomainWhere.WebsiteID.EQ(websiteId),
+	).All(ctx, db.Query)
+	if err != nil {
+		return err
+	}
+
+	existing := make([]string, 0, len(dbDomains))
+	for _, dbDomain := range dbDomains {
+		if !slices.Contains(patterns, dbDomain.Pattern) {
+			if _, err := dbDomain.Delete(ctx, db.Query); err != nil {
+				return err
+			}
+			continue
+		}
+		existing = append(existing, dbDomain.Pattern)
+	}
+
+	for _, pattern := range patterns {
+		if slices.Contains(existing, pattern) {
+			continue
+		}
+		dbDomain := &models.WebsiteDomain{
+			WebsiteID: websiteId,
+			Pattern:   pattern,
+		}
+		if err := dbDomain.Insert(ctx, db.Query, boil.Infer()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findWebsiteByDomain returns the website identified by the Origin header
+// whose domain pattern matches an origin most specifically.
+func findWebsiteByDomain(origin *helpers.Origin) (*models.Website, error) {
+	var match *helpers.DomainPattern
+	var website *models.Website
+	for _, policy := range corsPolicyList.all() {
+		pattern := policy.matchDomain(origin)
+		if pattern != nil && (match == nil || pattern.Specificity() > match.Specificity()) {
+			match = pattern
+			website = policy.website
+		}
+	}
+
+	// The lookup is resolved before checking whether the website allows it,
+	// so a more specific website never falls back to a less specific one.
+	if website == nil || !website.AllowOriginLookup {
+		return nil, fmt.Errorf("no website for origin %s", origin)
+	}
+	return website, nil
+}
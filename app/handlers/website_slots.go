@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"messages/app/db"
+	"messages/app/models"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	v "github.com/anthdm/superkit/validate"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// defaultSlot is the placement of the messages not assigned to a slot of
+// their website. It cannot be declared by websites.
+const defaultSlot = "default"
+
+// slotNameRegexp matches a slot name such as header or checkout-inline.
+var slotNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,49}$`)
+
+// isValidSlotName reports whether name can designate a slot, including the
+// default one.
+func isValidSlotName(name string) bool {
+	return slotNameRegexp.MatchString(name)
+}
+
+// parseSlotNames splits a list of slot names separated by commas or new
+// lines, lowercased and without duplicates.
+func parseSlotNames(list string) []string {
+	names := make([]string, 0)
+	for _, name := range strings.FieldsFunc(list, isListSeparator) {
+		name = strings.ToLower(name)
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// validSlots accepts a list of slot names, besides the default one.
+var validSlots = v.RuleSet{
+	Name: "slots",
+	MessageFunc: func(set v.RuleSet) string {
+		return fmt.Sprintf("must be a list of lowercase names (e.g. header, modal or checkout-inline), other than %s", defaultSlot)
+	},
+	ValidateFunc: func(rule v.RuleSet) bool {
+		str, _ := rule.FieldValue.(string)
+		for _, name := range parseSlotNames(str) {
+			if name == defaultSlot || !isValidSlotName(name) {
+				return false
+			}
+		}
+		return true
+	},
+}
+
+// getWebsiteSlotNames returns the slots declared by a website, in the order
+// they were declared.
+func getWebsiteSlotNames(ctx context.Context, websiteId int64) ([]string, error) {
+	dbSlots, err := models.WebsiteSlots(
+		models.WebsiteSlotWhere.WebsiteID.EQ(websiteId),
+		qm.OrderBy("id ASC"),
+	).All(ctx, db.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(dbSlots))
+	for _, dbSlot := range dbSlots {
+		names = append(names, dbSlot.Name)
+	}
+	return names, nil
+}
+
+// findSlotInUse returns the reason why the slots of a website cannot be
+// replaced by the given ones, empty when they can: a slot about to be removed
+// while messages are still assigned to it.
+func findSlotInUse(ctx context.Context, websiteId int64, names []string) (string, error) {
+	dbWebsiteMessage, err := models.WebsitesMessages(
+		models.WebsitesMessageWhere.WebsiteId.EQ(websiteId),
+		models.WebsitesMessageWhere.Slot.NEQ(""),
+		models.WebsitesMessageWhere.Slot.NIN(names),
+	).One(ctx, db.Query)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s is used by messages, assign them to another slot first", dbWebsiteMessage.Slot), nil
+}
+
+// upsertWebsiteSlots replaces the slots of a website.
+func upsertWebsiteSlots(ctx context.Context, websiteId int64, names []string) error {
+	dbSlots, err := models.WebsiteSlots(
+		models.WebsiteSlotWhere.WebsiteID.EQ(websiteId),
+	).All(ctx, db.Query)
+	if err != nil {
+		return err
+	}
+
+	existing := make([]string, 0, len(dbSlots))
+	for _, dbSlot := range dbSlots {
+		if !slices.Contains(names, dbSlot.Name) {
+			if _, err := dbSlot.Delete(ctx, db.Query); err != nil {
+				return err
+			}
+			continue
+		}
+		existing = append(existing, dbSlot.Name)
+	}
+
+	for _, name := range names {
+		if slices.Contains(existing, name) {
+			continue
+		}
+		dbSlot := &models.WebsiteSlot{
+			WebsiteID: websiteId,
+			Name:      name,
+		}
+		if err := dbSlot.Insert(ctx, db.Query, boil.Infer()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getWebsiteSlotOptions returns the slots declared by every website, keyed by
+// website id, for the message form.
+func getWebsiteSlotOptions(ctx context.Context) map[string][]string {
+	dbSlots, err := models.WebsiteSlots(
+		qm.OrderBy("id ASC"),
+	).All(ctx, db.Query)
+	if err != nil {
+		return nil
+	}
+
+	options := make(map[string][]string)
+	for _, dbSlot := range dbSlots {
+		websiteId := fmt.Sprintf("%d", dbSlot.WebsiteID)
+		options[websiteId] = append(options[websiteId], dbSlot.Name)
+	}
+	return options
+}
+
+// parseMessageSlots reads the slot_<id> fields of the message form for each
+// of the given websites. A slot must be declared by its website. Validation
+// errors are added to errors.
+func parseMessageSlots(r *http.Request, websiteSlots map[string][]string, errors v.Errors) (map[string]string, bool) {
+	slots := make(map[string]string)
+	valid := true
+
+	for websiteId, names := range websiteSlots {
+		slot := r.FormValue("slot_" + websiteId)
+		if slot == "" {
+			continue
+		}
+		slots[websiteId] = slot
+
+		if !slices.Contains(names, slot) {
+			errors.Add("slot_"+websiteId, "must be one of the slots of the website")
+			valid = false
+		}
+	}
+
+	return slots, valid
+}
+
+// getMessageSlotValues returns the slots a message is assigned to, keyed by
+// website id.
+func getMessageSlotValues(ctx context.Context, messageId int64) (map[string]string, error) {
+	dbWebsitesMessages, err := models.WebsitesMessages(
+		models.WebsitesMessageWhere.MessageId.EQ(messageId),
+		models.WebsitesMessageWhere.Slot.NEQ(""),
+	).All(ctx, db.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := make(map[string]string, len(dbWebsitesMessages))
+	for _, websiteMessage := range dbWebsitesMessages {
+		slots[fmt.Sprintf("%d", websiteMessage.WebsiteId)] = websiteMessage.Slot
+	}
+	return slots, nil
+}
+
+// getMessageSlot returns the slot occupied by a message on a website.
+func getMessageSlot(link *models.WebsitesMessage) string {
+	if link == nil || link.Slot == "" {
+		return defaultSlot
+	}
+	return link.Slot
+}
+
+// filterMessagesBySlot returns the messages occupying one of the given slots,
+// or all of them when no slot is given.
+func filterMessagesBySlot(messages []Message, slots []string) []Message {
+	if len(slots) == 0 {
+		return messages
+	}
+	filtered := make([]Message, 0, len(messages))
+	for _, message := range messages {
+		if slices.Contains(slots, message.Slot) {
+			filtered = append(filtered, message)
+		}
+	}
+	return filtered
+}
+
+// groupMessagesBySlot groups messages by the slot they occupy, keeping their
+// order within each slot.
+func groupMessagesBySlot(messages []Message) map[string][]Message {
+	groups := make(map[string][]Message)
+	for _, message := range messages {
+		groups[message.Slot] = append(groups[message.Slot], message)
+	}
+	return groups
+}
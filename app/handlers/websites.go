@@ -5,33 +5,50 @@ import (
 	"messages/app/db"
 	"messages/app/helpers"
 	"messages/app/models"
+	"messages/app/schedule"
 	"messages/app/views/websites"
 	"messages/plugins/auth"
+	"strings"
 
 	v "github.com/anthdm/superkit/validate"
 
 	"github.com/anthdm/superkit/kit"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
+// widgetScriptPath is the path of the current version of the embeddable widget.
+const widgetScriptPath = "/public/assets/widget/v1/messages.js"
+
 func HandleWebsitesList(kit *kit.Kit) error {
 	data := &websites.IndexPageData{
-		FormValues: getBaseWebsiteFormValues(),
+		FormValues:   getBaseWebsiteFormValues(),
+		FormSettings: getBaseWebsiteFormSettings(),
 	}
 
-	dbWebsitesList, err := models.Websites().All(kit.Request.Context(), db.Query)
+	dbWebsitesList, err := models.Websites(
+		qm.Load(models.WebsiteRels.WebsiteDomains, qm.OrderBy("id ASC")),
+	).All(kit.Request.Context(), db.Query)
 	if err != nil {
 		return helpers.RenderNoticeError(kit, err)
 	}
 
 	websitesList := make([]*websites.WebsiteListItem, 0, len(dbWebsitesList))
 	for _, website := range dbWebsitesList {
-		websitesList = append(websitesList, &websites.WebsiteListItem{
-			ID:      website.ID,
-			Name:    website.Name,
-			Domain:  website.URL,
-			Staging: website.Staging,
-		})
+		item := &websites.WebsiteListItem{
+			ID:            website.ID,
+			Name:          website.Name,
+			Domain:        website.URL,
+			DomainAliases: make([]string, 0, len(website.R.WebsiteDomains)),
+			Staging:       website.Staging,
+		}
+		for _, dbDomain := range website.R.WebsiteDomains {
+			if dbDomain.Pattern != website.URL {
+				item.DomainAliases = append(item.DomainAliases, dbDomain.Pattern)
+			}
+		}
+		websitesList = append(websitesList, item)
 	}
 	data.WebsitesList = websitesList
 
@@ -50,49 +67,202 @@ func HandleWebsiteGet(kit *kit.Kit) error {
 		return helpers.RenderNoticeError(kit, err)
 	}
 
+	apiKeys, err := getApiKeysSectionData(kit.Request.Context(), websiteId, getUserLocation(kit))
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+
 	data := &websites.PageWebsiteEditData{
-		FormValues: getBaseWebsiteFormValues(),
-		FormErrors: v.Errors{},
+		FormValues:      getBaseWebsiteFormValues(),
+		FormSettings:    getBaseWebsiteFormSettings(),
+		FormErrors:      v.Errors{},
+		ApiKeys:         apiKeys,
+		WidgetScriptURL: helpers.BaseURL(kit.Request) + widgetScriptPath,
 	}
 
 	data.FormValues.Name = dbWebsite.Name
 	data.FormValues.Domain = dbWebsite.URL
+	if data.FormValues.DomainAliases, err = getWebsiteDomainAliases(kit.Request.Context(), dbWebsite); err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
 	data.FormValues.ID = dbWebsite.ID
 	data.FormValues.Staging = dbWebsite.Staging
+	data.FormValues.AllowOriginLookup = dbWebsite.AllowOriginLookup
+	data.FormValues.FallbackLanguage = dbWebsite.FallbackLanguage
+	data.FormValues.Timezone = dbWebsite.Timezone
+	data.FormValues.ForbidLinks = dbWebsite.ForbidLinks
+	data.FormValues.ForbidImages = dbWebsite.ForbidImages
+	data.FormValues.LinkTracking = dbWebsite.LinkTracking
+	data.FormValues.AllowedURLSchemes = dbWebsite.AllowedURLSchemes
+	data.FormValues.CorsOrigins = dbWebsite.CorsOrigins
+	data.FormValues.CorsMaxAge = int(dbWebsite.CorsMaxAge)
+	data.FormValues.RateLimit = int(dbWebsite.RateLimit)
+	data.FormValues.RateBurst = int(dbWebsite.RateBurst)
+	slots, err := getWebsiteSlotNames(kit.Request.Context(), dbWebsite.ID)
+	if err != nil {
+		return helpers.RenderNoticeError(kit, err)
+	}
+	data.FormValues.Slots = strings.Join(slots, "\n")
+	data.RejectedRequests = dbWebsite.RejectedRequests + apiRateLimits.Rejected(dbWebsite.ID)
 
 	return kit.Render(websites.PageWebsiteEdit(data))
 }
 
 var createWebsiteSchema = v.Schema{
-	"name":    v.Rules(v.Required),
-	"domain":  v.Rules(v.Required, helpers.ValidDomain),
-	"staging": v.Rules(),
+	"name":                v.Rules(v.Required),
+	"domain":              v.Rules(v.Required, helpers.ValidDomain),
+	"domainAliases":       v.Rules(validDomainAliases),
+	"staging":             v.Rules(),
+	"allow_origin_lookup": v.Rules(),
+	"fallbackLanguage":    v.Rules(validFallbackLanguage),
+	"timezone":            v.Rules(validTimezone),
+	"forbidLinks":         v.Rules(),
+	"forbidImages":        v.Rules(),
+	"linkTracking":        v.Rules(),
+	"allowedURLSchemes":   v.Rules(validURLSchemes),
+	"corsOrigins":         v.Rules(validCorsOrigins),
+	"corsMaxAge":          v.Rules(v.GTE(0), v.LTE(86400)),
+	"rateLimit":           v.Rules(v.GTE(0)),
+	"rateBurst":           v.Rules(v.GTE(0)),
+	"slots":               v.Rules(validSlots),
+}
+
+// validFallbackLanguage accepts a supported language, or nothing.
+var validFallbackLanguage = v.RuleSet{
+	Name: "fallbackLanguage",
+	MessageFunc: func(set v.RuleSet) string {
+		return "must be a supported language"
+	},
+	ValidateFunc: func(rule v.RuleSet) bool {
+		str, _ := rule.FieldValue.(string)
+		return str == "" || IsValidLanguage(str)
+	},
+}
+
+// validTimezone accepts an IANA timezone, or nothing for the default one.
+var validTimezone = v.RuleSet{
+	Name: "timezone",
+	MessageFunc: func(set v.RuleSet) string {
+		return "must be an IANA timezone, such as America/Toronto"
+	},
+	ValidateFunc: func(rule v.RuleSet) bool {
+		str, _ := rule.FieldValue.(string)
+		if str == "" {
+			return true
+		}
+		_, err := schedule.LoadLocation(str)
+		return err == nil
+	},
+}
+
+// validURLSchemes accepts a comma separated list of URL schemes.
+var validURLSchemes = v.RuleSet{
+	Name: "urlSchemes",
+	MessageFunc: func(set v.RuleSet) string {
+		return "must be a comma separated list of URL schemes (e.g. https,mailto)"
+	},
+	ValidateFunc: func(rule v.RuleSet) bool {
+		str, _ := rule.FieldValue.(string)
+		_, ok := helpers.ParseURLSchemes(str)
+		return ok
+	},
+}
+
+// normalizeURLSchemes formats a validated list of URL schemes for storage. An
+// empty list stands for the default schemes.
+func normalizeURLSchemes(list string) string {
+	schemes, _ := helpers.ParseURLSchemes(list)
+	if len(schemes) == 0 {
+		schemes = helpers.DefaultURLSchemes
+	}
+	return strings.Join(schemes, ",")
+}
+
+// validCorsOrigins accepts a list of web origins separated by commas or new
+// lines.
+var validCorsOrigins = v.RuleSet{
+	Name: "corsOrigins",
+	MessageFunc: func(set v.RuleSet) string {
+		return "must be a list of origins (e.g. https://www.example.com:8443)"
+	},
+	ValidateFunc: func(rule v.RuleSet) bool {
+		str, _ := rule.FieldValue.(string)
+		for _, origin := range strings.FieldsFunc(str, isListSeparator) {
+			if _, ok := helpers.NormalizeOrigin(origin); !ok {
+				return false
+			}
+		}
+		return true
+	},
+}
+
+// normalizeCorsOrigins formats a validated list of origins for storage.
+func normalizeCorsOrigins(list string) string {
+	return strings.Join(parseCorsOrigins(list), "\n")
 }
 
 func HandleWebsiteCreate(kit *kit.Kit) error {
 	formValues := getBaseWebsiteFormValues()
 	errors := v.Errors{}
 
-	if err := helpers.VerifyAdminRole(kit.Auth().(auth.Auth)); err != nil {
+	if err := verifyAdminRole(kit.Auth().(auth.Auth)); err != nil {
 		errors.Add("form", "You are not allowed to create websites")
-		return kit.Render(websites.WebsiteForm(formValues, errors))
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
 	}
 
 	if errors, ok := v.Request(kit.Request, formValues, createWebsiteSchema); !ok {
-		return kit.Render(websites.WebsiteForm(formValues, errors))
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
+	}
+
+	domainPatterns := getWebsiteDomainPatterns(formValues.Domain, formValues.DomainAliases)
+	if err := checkDevelopmentPatterns(domainPatterns, formValues.Staging); err != nil {
+		errors.Add("domainAliases", err.Error())
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
+	}
+	conflict, err := findDomainConflict(kit.Request.Context(), 0, domainPatterns)
+	if err != nil {
+		errors.Add("form", "Failed to check the website domains")
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
+	}
+	if conflict != "" {
+		errors.Add("domainAliases", conflict)
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
 	}
 
 	dbWebsite := models.Website{
-		Name:    formValues.Name,
-		URL:     formValues.Domain,
-		Staging: formValues.Staging,
+		Name:              formValues.Name,
+		URL:               formValues.Domain,
+		Staging:           formValues.Staging,
+		AllowOriginLookup: formValues.AllowOriginLookup,
+		FallbackLanguage:  formValues.FallbackLanguage,
+		Timezone:          formValues.Timezone,
+		ForbidLinks:       formValues.ForbidLinks,
+		ForbidImages:      formValues.ForbidImages,
+		LinkTracking:      formValues.LinkTracking,
+		AllowedURLSchemes: normalizeURLSchemes(formValues.AllowedURLSchemes),
+		CorsOrigins:       normalizeCorsOrigins(formValues.CorsOrigins),
+		CorsMaxAge:        int64(formValues.CorsMaxAge),
+		RateLimit:         int64(formValues.RateLimit),
+		RateBurst:         int64(formValues.RateBurst),
 	}
 
 	if err := dbWebsite.Insert(kit.Request.Context(), db.Query, boil.Infer()); err != nil {
 		errors.Add("form", "Failed to create website")
-		return kit.Render(websites.WebsiteForm(formValues, errors))
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
+	}
+
+	if err := upsertWebsiteDomains(kit.Request.Context(), dbWebsite.ID, domainPatterns); err != nil {
+		errors.Add("form", "Failed to create website domains")
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
+	}
+
+	if err := upsertWebsiteSlots(kit.Request.Context(), dbWebsite.ID, parseSlotNames(formValues.Slots)); err != nil {
+		errors.Add("form", "Failed to create website slots")
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
 	}
 
+	corsPolicyList.invalidate()
+
 	return kit.Redirect(200, "/websites")
 }
 
@@ -105,26 +275,79 @@ func HandleWebsiteUpdate(kit *kit.Kit) error {
 		return err
 	}
 
-	if err := helpers.VerifyAdminRole(kit.Auth().(auth.Auth)); err != nil {
+	if err := verifyAdminRole(kit.Auth().(auth.Auth)); err != nil {
 		errors.Add("form", "You are not allowed to update websites")
-		return kit.Render(websites.WebsiteForm(formValues, errors))
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
 	}
 
 	if errors, ok := v.Request(kit.Request, formValues, createWebsiteSchema); !ok {
-		return kit.Render(websites.WebsiteForm(formValues, errors))
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
+	}
+
+	domainPatterns := getWebsiteDomainPatterns(formValues.Domain, formValues.DomainAliases)
+	if err := checkDevelopmentPatterns(domainPatterns, formValues.Staging); err != nil {
+		errors.Add("domainAliases", err.Error())
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
+	}
+	conflict, err := findDomainConflict(kit.Request.Context(), formValues.ID, domainPatterns)
+	if err != nil {
+		errors.Add("form", "Failed to check the website domains")
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
+	}
+	if conflict != "" {
+		errors.Add("domainAliases", conflict)
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
+	}
+
+	slots := parseSlotNames(formValues.Slots)
+	slotInUse, err := findSlotInUse(kit.Request.Context(), formValues.ID, slots)
+	if err != nil {
+		errors.Add("form", "Failed to check the website slots")
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
+	}
+	if slotInUse != "" {
+		errors.Add("slots", slotInUse)
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
 	}
 
 	if _, err := models.Websites(
 		models.WebsiteWhere.ID.EQ(formValues.ID),
 	).UpdateAll(kit.Request.Context(), db.Query, models.M{
-		models.WebsiteColumns.Name:    formValues.Name,
-		models.WebsiteColumns.URL:     formValues.Domain,
-		models.WebsiteColumns.Staging: formValues.Staging,
+		models.WebsiteColumns.Name:              formValues.Name,
+		models.WebsiteColumns.URL:               formValues.Domain,
+		models.WebsiteColumns.Staging:           formValues.Staging,
+		models.WebsiteColumns.AllowOriginLookup: formValues.AllowOriginLookup,
+		models.WebsiteColumns.FallbackLanguage:  formValues.FallbackLanguage,
+		models.WebsiteColumns.Timezone:          formValues.Timezone,
+		models.WebsiteColumns.ForbidLinks:       formValues.ForbidLinks,
+		models.WebsiteColumns.ForbidImages:      formValues.ForbidImages,
+		models.WebsiteColumns.LinkTracking:      formValues.LinkTracking,
+		models.WebsiteColumns.AllowedURLSchemes: normalizeURLSchemes(formValues.AllowedURLSchemes),
+		models.WebsiteColumns.CorsOrigins:       normalizeCorsOrigins(formValues.CorsOrigins),
+		models.WebsiteColumns.CorsMaxAge:        formValues.CorsMaxAge,
+		models.WebsiteColumns.RateLimit:         formValues.RateLimit,
+		models.WebsiteColumns.RateBurst:         formValues.RateBurst,
 	}); err != nil {
 		errors.Add("form", "Failed to update website")
-		return kit.Render(websites.WebsiteForm(formValues, errors))
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
+	}
+
+	if err := upsertWebsiteDomains(kit.Request.Context(), formValues.ID, domainPatterns); err != nil {
+		errors.Add("form", "Failed to update website domains")
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
 	}
 
+	if err := upsertWebsiteSlots(kit.Request.Context(), formValues.ID, slots); err != nil {
+		errors.Add("form", "Failed to update website slots")
+		return kit.Render(websites.WebsiteForm(formValues, getBaseWebsiteFormSettings(), errors))
+	}
+
+	// The catalog is invalidated first, so the entries rebuilt and the
+	// streams notified read the updated website.
+	corsPolicyList.invalidate()
+	invalidateApiMessagesCache(formValues.ID)
+	apiRateLimits.Reset(formValues.ID)
+
 	return kit.Redirect(200, "/websites")
 }
 
@@ -135,22 +358,70 @@ func HandleWebsiteDelete(kit *kit.Kit) error {
 		return helpers.RenderNoticeError(kit, err)
 	}
 
-	if err := helpers.VerifyAdminRole(kit.Auth().(auth.Auth)); err != nil {
+	if err := verifyAdminRole(kit.Auth().(auth.Auth)); err != nil {
 		return helpers.RenderNoticeError(kit, errors.New("You are not allowed to delete websites"))
 	}
 
+	if _, err := models.WebsiteAPIKeys(
+		models.WebsiteAPIKeyWhere.WebsiteID.EQ(websiteId),
+	).DeleteAll(kit.Request.Context(), db.Query); err != nil {
+		return helpers.RenderNoticeError(kit, errors.New("Failed to delete website API keys"))
+	}
+
+	if _, err := models.WebsiteDomains(
+		models.WebsiteDomainWhere.WebsiteID.EQ(websiteId),
+	).DeleteAll(kit.Request.Context(), db.Query); err != nil {
+		return helpers.RenderNoticeError(kit, errors.New("Failed to delete website domains"))
+	}
+
+	if _, err := models.WebsiteSlots(
+		models.WebsiteSlotWhere.WebsiteID.EQ(websiteId),
+	).DeleteAll(kit.Request.Context(), db.Query); err != nil {
+		return helpers.RenderNoticeError(kit, errors.New("Failed to delete website slots"))
+	}
+
+	if _, err := models.MessageStats(
+		models.MessageStatWhere.WebsiteID.EQ(websiteId),
+	).DeleteAll(kit.Request.Context(), db.Query); err != nil {
+		return helpers.RenderNoticeError(kit, errors.New("Failed to delete website statistics"))
+	}
+
+	if _, err := models.MessageLinkClicks(
+		models.MessageLinkClickWhere.WebsiteID.EQ(websiteId),
+	).DeleteAll(kit.Request.Context(), db.Query); err != nil {
+		return helpers.RenderNoticeError(kit, errors.New("Failed to delete website statistics"))
+	}
+
+	if _, err := models.PreviewTokens(
+		models.PreviewTokenWhere.WebsiteID.EQ(null.Int64From(websiteId)),
+	).DeleteAll(kit.Request.Context(), db.Query); err != nil {
+		return helpers.RenderNoticeError(kit, errors.New("Failed to delete website preview tokens"))
+	}
+
 	if _, err := models.Websites(
 		models.WebsiteWhere.ID.EQ(websiteId),
 	).DeleteAll(kit.Request.Context(), db.Query); err != nil {
 		return helpers.RenderNoticeError(kit, errors.New("Failed to delete website"))
 	}
 
+	corsPolicyList.invalidate()
+	invalidateApiMessagesCache(websiteId)
+	apiRateLimits.Reset(websiteId)
+
 	return kit.Redirect(200, "/websites")
 }
 
 func getBaseWebsiteFormValues() *websites.WebsiteFormValues {
 	return &websites.WebsiteFormValues{
-		Name:   "",
-		Domain: "",
+		Name:              "",
+		Domain:            "",
+		AllowedURLSchemes: strings.Join(helpers.DefaultURLSchemes, ","),
+	}
+}
+
+func getBaseWebsiteFormSettings() *websites.WebsiteFormSettings {
+	return &websites.WebsiteFormSettings{
+		Languages: getLanguageOptions(),
+		Timezones: schedule.Timezones,
 	}
 }
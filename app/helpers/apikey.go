@@ -0,0 +1,57 @@
+package helpers
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+)
+
+const (
+	apiKeyPrefix       = "msk_"
+	previewTokenPrefix = "msp_"
+	apiKeyDisplayChars = 12
+)
+
+// GenerateAPIKey returns a new random API key along with the prefix
+// displayed in the admin UI and the hash stored in the database.
+func GenerateAPIKey() (key string, prefix string, hash string, err error) {
+	return generateSecret(apiKeyPrefix)
+}
+
+func generateSecret(secretPrefix string) (secret string, prefix string, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", "", err
+	}
+
+	secret = secretPrefix + base64.RawURLEncoding.EncodeToString(buf)
+	return secret, secret[:apiKeyDisplayChars], HashAPIKey(secret), nil
+}
+
+// HashAPIKey returns the hash under which an API key or a preview token is
+// stored.
+// Keys are long random strings, so a plain SHA-256 is enough here.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// GetAPIKey reads the API key from the X-Api-Key header or the api_key
+// query parameter.
+func GetAPIKey(r *http.Request) string {
+	if key := r.Header.Get("X-Api-Key"); key != "" {
+		return key
+	}
+	return r.URL.Query().Get("api_key")
+}
+
+// GetPreviewToken reads the preview token from the X-Preview-Token header or
+// the preview query parameter.
+func GetPreviewToken(r *http.Request) string {
+	if token := r.Header.Get("X-Preview-Token"); token != "" {
+		return token
+	}
+	return r.URL.Query().Get("preview")
+}
@@ -0,0 +1,216 @@
+package helpers
+
+import (
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// wildcardPrefix starts the domain patterns matching every subdomain of a
+// domain, such as *.example.com.
+const wildcardPrefix = "*."
+
+// Origin is the origin of a request to the API, from its Origin or Referer
+// header. Scheme and Port are empty when not given.
+type Origin struct {
+	Scheme string
+	Host   string
+	Port   string
+}
+
+// ParseOrigin parses an Origin or Referer header. Besides URLs, a bare host
+// (e.g. example.com) is accepted, as sent by clients predating this parsing.
+func ParseOrigin(value string) (*Origin, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "null" {
+		return nil, false
+	}
+	if !strings.Contains(value, "://") {
+		value = "//" + value
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || u.User != nil {
+		return nil, false
+	}
+	if u.Scheme != "" && u.Scheme != "http" && u.Scheme != "https" {
+		return nil, false
+	}
+
+	origin := &Origin{
+		Scheme: strings.ToLower(u.Scheme),
+		Host:   normalizeHost(u.Hostname()),
+		Port:   u.Port(),
+	}
+	if !IsValidDomain(origin.Host) && !IsDevelopmentHost(origin.Host) {
+		return nil, false
+	}
+	return origin, true
+}
+
+// String serializes the origin, e.g. https://example.com:8443.
+func (o *Origin) String() string {
+	host := o.Host
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	if o.Port != "" {
+		host += ":" + o.Port
+	}
+	if o.Scheme == "" {
+		return host
+	}
+	return o.Scheme + "://" + host
+}
+
+// effectivePort returns the port of the origin, implied by its scheme when
+// not given.
+func (o *Origin) effectivePort() string {
+	switch {
+	case o.Port != "":
+		return o.Port
+	case o.Scheme == "https":
+		return "443"
+	case o.Scheme == "http":
+		return "80"
+	}
+	return ""
+}
+
+// normalizeHost lowercases a host, drops its trailing dot and converts an
+// internationalized domain name to its ASCII form, as sent by browsers (e.g.
+// bücher.example to xn--bcher-kva.example).
+func normalizeHost(host string) string {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if ascii, err := idna.Lookup.ToASCII(host); err == nil {
+		return ascii
+	}
+	return host
+}
+
+// IsDevelopmentHost reports whether host is localhost or an IP address, which
+// only staging websites may register.
+func IsDevelopmentHost(host string) bool {
+	return host == "localhost" || strings.HasSuffix(host, ".localhost") || net.ParseIP(host) != nil
+}
+
+// DomainPattern matches the origins of a website. Host is a domain, a
+// wildcard pattern (e.g. *.example.com) or a development host. Scheme and
+// Port restrict the match when given.
+type DomainPattern struct {
+	Scheme string
+	Host   string
+	Port   string
+}
+
+// portRegexp matches a TCP port.
+var portRegexp = regexp.MustCompile(`^[0-9]{1,5}$`)
+
+// ParseDomainPattern parses a domain pattern such as example.com,
+// *.example.com, https://example.com or localhost:8080.
+func ParseDomainPattern(pattern string) (*DomainPattern, bool) {
+	p := &DomainPattern{}
+	hostPort := strings.ToLower(strings.TrimSpace(pattern))
+	if scheme, rest, ok := strings.Cut(hostPort, "://"); ok {
+		if scheme != "http" && scheme != "https" {
+			return nil, false
+		}
+		p.Scheme = scheme
+		hostPort = rest
+	}
+
+	p.Host = hostPort
+	if strings.HasPrefix(hostPort, "[") {
+		host, port, err := net.SplitHostPort(hostPort)
+		if err != nil {
+			host = strings.TrimSuffix(strings.TrimPrefix(hostPort, "["), "]")
+		}
+		p.Host, p.Port = host, port
+	} else if host, port, ok := strings.Cut(hostPort, ":"); ok {
+		p.Host, p.Port = host, port
+	}
+	if p.Port != "" && !portRegexp.MatchString(p.Port) {
+		return nil, false
+	}
+
+	domain, wildcard := strings.CutPrefix(p.Host, wildcardPrefix)
+	domain = normalizeHost(domain)
+	if !IsValidDomain(domain) && (wildcard || !IsDevelopmentHost(domain)) {
+		return nil, false
+	}
+	p.Host = domain
+	if wildcard {
+		p.Host = wildcardPrefix + domain
+	}
+	return p, true
+}
+
+// IsValidDomainPattern reports whether pattern is a valid domain pattern.
+func IsValidDomainPattern(pattern string) bool {
+	_, ok := ParseDomainPattern(pattern)
+	return ok
+}
+
+// IsDevelopment reports whether the pattern designates localhost or an IP
+// address.
+func (p *DomainPattern) IsDevelopment() bool {
+	return IsDevelopmentHost(p.Host)
+}
+
+// Match reports whether an origin matches the pattern. A wildcard pattern
+// matches the subdomains of its domain at any depth, but not the domain
+// itself.
+func (p *DomainPattern) Match(origin *Origin) bool {
+	if p.Scheme != "" && p.Scheme != origin.Scheme {
+		return false
+	}
+	if p.Port != "" && p.Port != origin.effectivePort() {
+		return false
+	}
+	if domain, ok := strings.CutPrefix(p.Host, wildcardPrefix); ok {
+		return strings.HasSuffix(origin.Host, "."+domain)
+	}
+	return p.Host == origin.Host
+}
+
+// Overlaps reports whether an origin can match both patterns.
+func (p *DomainPattern) Overlaps(other *DomainPattern) bool {
+	if p.Scheme != "" && other.Scheme != "" && p.Scheme != other.Scheme {
+		return false
+	}
+	if p.Port != "" && other.Port != "" && p.Port != other.Port {
+		return false
+	}
+
+	domain, wildcard := strings.CutPrefix(p.Host, wildcardPrefix)
+	otherDomain, otherWildcard := strings.CutPrefix(other.Host, wildcardPrefix)
+	switch {
+	case wildcard && otherWildcard:
+		return domain == otherDomain || strings.HasSuffix(domain, "."+otherDomain) || strings.HasSuffix(otherDomain, "."+domain)
+	case wildcard:
+		return strings.HasSuffix(other.Host, "."+domain)
+	case otherWildcard:
+		return strings.HasSuffix(p.Host, "."+otherDomain)
+	}
+	return p.Host == other.Host
+}
+
+// Specificity ranks the patterns matching an origin: exact hosts first, then
+// wildcards by decreasing length, then patterns restricting the scheme and
+// the port.
+func (p *DomainPattern) Specificity() int {
+	specificity := 1 << 10
+	if domain, ok := strings.CutPrefix(p.Host, wildcardPrefix); ok {
+		specificity = (strings.Count(domain, ".") + 1) << 2
+	}
+	if p.Scheme != "" {
+		specificity += 2
+	}
+	if p.Port != "" {
+		specificity++
+	}
+	return specificity
+}
@@ -0,0 +1,171 @@
+package helpers
+
+import (
+	"sort"
+	"testing"
+)
+
+func mustParseDomainPattern(t *testing.T, pattern string) *DomainPattern {
+	t.Helper()
+	p, ok := ParseDomainPattern(pattern)
+	if !ok {
+		t.Fatalf("%s should parse", pattern)
+	}
+	return p
+}
+
+func TestParseOrigin(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+		host  string
+	}{
+		{"https://example.com", "https://example.com", "example.com"},
+		{"https://Shop.EXAMPLE.com", "https://shop.example.com", "shop.example.com"},
+		{"https://example.com:8443", "https://example.com:8443", "example.com"},
+		{"https://example.com/some/page?q=1#top", "https://example.com", "example.com"},
+		{"example.com", "example.com", "example.com"},
+		{" https://example.com ", "https://example.com", "example.com"},
+		{"https://example.com.", "https://example.com", "example.com"},
+		{"https://bücher.example", "https://xn--bcher-kva.example", "xn--bcher-kva.example"},
+		{"https://BÜCHER.example", "https://xn--bcher-kva.example", "xn--bcher-kva.example"},
+		{"https://xn--bcher-kva.example", "https://xn--bcher-kva.example", "xn--bcher-kva.example"},
+		{"http://localhost:8080", "http://localhost:8080", "localhost"},
+		{"http://127.0.0.1:3000", "http://127.0.0.1:3000", "127.0.0.1"},
+		{"http://[::1]:3000", "http://[::1]:3000", "::1"},
+	}
+	for _, tt := range tests {
+		origin, ok := ParseOrigin(tt.value)
+		if !ok {
+			t.Errorf("ParseOrigin(%q) failed", tt.value)
+			continue
+		}
+		if origin.String() != tt.want || origin.Host != tt.host {
+			t.Errorf("ParseOrigin(%q) = %s with host %s, want %s with host %s", tt.value, origin, origin.Host, tt.want, tt.host)
+		}
+	}
+
+	for _, value := range []string{"", "null", "ftp://example.com", "https://user@example.com", "https://exa mple.com", "https://-example.com", "https://example", "https://example.com:port"} {
+		if origin, ok := ParseOrigin(value); ok {
+			t.Errorf("ParseOrigin(%q) = %s, want an error", value, origin)
+		}
+	}
+}
+
+func TestDomainPatternMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		origin  string
+		want    bool
+	}{
+		{"example.com", "https://example.com", true},
+		{"example.com", "http://example.com:8080", true},
+		{"example.com", "https://www.example.com", false},
+		{"Example.COM", "https://EXAMPLE.com", true},
+		{"example.com.", "https://example.com", true},
+		{"example.com", "https://example.com.", true},
+		{"*.example.com", "https://shop.example.com", true},
+		{"*.example.com", "https://a.b.shop.example.com", true},
+		{"*.example.com", "https://example.com", false},
+		{"*.example.com", "https://shopexample.com", false},
+		{"*.shop.example.com", "https://shop.example.com", false},
+		{"*.shop.example.com", "https://eu.shop.example.com", true},
+		{"https://example.com", "https://example.com", true},
+		{"https://example.com", "http://example.com", false},
+		{"https://example.com", "example.com", false},
+		{"example.com:8443", "https://example.com:8443", true},
+		{"example.com:8443", "https://example.com", false},
+		{"example.com:443", "https://example.com", true},
+		{"example.com:80", "http://example.com", true},
+		{"example.com:443", "http://example.com", false},
+		{"bücher.example", "https://xn--bcher-kva.example", true},
+		{"*.bücher.example", "https://shop.bücher.example", true},
+		{"localhost:8080", "http://localhost:8080", true},
+		{"localhost:8080", "http://localhost:3000", false},
+		{"http://[::1]:3000", "http://[::1]:3000", true},
+	}
+	for _, tt := range tests {
+		pattern := mustParseDomainPattern(t, tt.pattern)
+		origin, ok := ParseOrigin(tt.origin)
+		if !ok {
+			t.Fatalf("%s should parse", tt.origin)
+		}
+		if got := pattern.Match(origin); got != tt.want {
+			t.Errorf("%s matches %s = %t, want %t", tt.pattern, tt.origin, got, tt.want)
+		}
+	}
+
+	for _, pattern := range []string{"", "*", "*.com.", "ftp://example.com", "*.localhost", "example.com:http", "shop.*.example.com"} {
+		if p, ok := ParseDomainPattern(pattern); ok {
+			t.Errorf("ParseDomainPattern(%q) = %+v, want an error", pattern, p)
+		}
+	}
+}
+
+func TestDomainPatternSpecificity(t *testing.T) {
+	// From the most specific pattern matching https://eu.shop.example.com.
+	want := []string{
+		"https://eu.shop.example.com",
+		"eu.shop.example.com",
+		"*.shop.example.com",
+		"https://*.example.com:443",
+		"https://*.example.com",
+		"*.example.com:443",
+		"*.example.com",
+	}
+
+	origin, _ := ParseOrigin("https://eu.shop.example.com")
+	type rankedPattern struct {
+		text    string
+		pattern *DomainPattern
+	}
+	patterns := make([]rankedPattern, 0, len(want))
+	for i := len(want) - 1; i >= 0; i-- {
+		pattern := mustParseDomainPattern(t, want[i])
+		if !pattern.Match(origin) {
+			t.Fatalf("%s should match %s", want[i], origin)
+		}
+		patterns = append(patterns, rankedPattern{want[i], pattern})
+	}
+
+	sort.SliceStable(patterns, func(i, j int) bool {
+		return patterns[i].pattern.Specificity() > patterns[j].pattern.Specificity()
+	})
+	for i, p := range patterns {
+		if p.text != want[i] {
+			t.Errorf("pattern %d = %s, want %s", i, p.text, want[i])
+		}
+	}
+}
+
+func TestDomainPatternOverlaps(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"example.com", "example.com", true},
+		{"example.com", "www.example.com", false},
+		{"*.example.com", "shop.example.com", true},
+		{"*.example.com", "a.b.example.com", true},
+		{"*.example.com", "example.com", false},
+		{"*.example.com", "*.shop.example.com", true},
+		{"*.example.com", "*.example.org", false},
+		{"*.example.com", "*.badexample.com", false},
+		{"*.example.com", "shop.badexample.com", false},
+		{"https://example.com", "http://example.com", false},
+		{"https://example.com", "example.com", true},
+		{"example.com:8443", "example.com:443", false},
+		{"example.com:8443", "https://example.com", true},
+		{"localhost:3000", "localhost:8080", false},
+		{"localhost", "http://localhost:8080", true},
+	}
+	for _, tt := range tests {
+		a, b := mustParseDomainPattern(t, tt.a), mustParseDomainPattern(t, tt.b)
+		if got := a.Overlaps(b); got != tt.want {
+			t.Errorf("%s overlaps %s = %t, want %t", tt.a, tt.b, got, tt.want)
+		}
+		if got := b.Overlaps(a); got != tt.want {
+			t.Errorf("%s overlaps %s = %t, want %t", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
@@ -3,7 +3,6 @@ package helpers
 import (
 	"errors"
 	component_notice "messages/app/views/components/notices"
-	"messages/plugins/auth"
 	"strconv"
 
 	"github.com/anthdm/superkit/kit"
@@ -19,13 +18,6 @@ func GetIdFromUrl(kit *kit.Kit) (int64, error) {
 	return websiteId, nil
 }
 
-func VerifyAdminRole(auth auth.Auth) error {
-	if auth.Role != "admin" {
-		return errors.New("You are not allowed to perform this action")
-	}
-	return nil
-}
-
 func RenderNoticeError(kit *kit.Kit, err error) error {
 	return kit.Render(component_notice.Notice(&component_notice.NoticeProps{
 		Title:   "Error",
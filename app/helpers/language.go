@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// languageRangeRegexp matches a language range as defined by RFC 4647,
+// lowercased.
+var languageRangeRegexp = regexp.MustCompile(`^(\*|[a-z]{1,8}(-[a-z0-9]{1,8})*)$`)
+
+// ParseAcceptLanguage returns the language ranges of an Accept-Language
+// header (RFC 9110, section 12.5.4) lowercased and ordered by decreasing
+// quality. Ranges with a quality of zero and malformed entries are dropped.
+func ParseAcceptLanguage(header string) []string {
+	type languageRange struct {
+		tag     string
+		quality float64
+	}
+
+	ranges := make([]languageRange, 0)
+	for _, part := range strings.Split(header, ",") {
+		params := strings.Split(part, ";")
+		tag := strings.ToLower(strings.TrimSpace(params[0]))
+		if !languageRangeRegexp.MatchString(tag) {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range params[1:] {
+			name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.TrimSpace(name) != "q" {
+				continue
+			}
+			q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || q < 0 || q > 1 {
+				q = 0
+			}
+			quality = q
+		}
+		if quality == 0 {
+			continue
+		}
+
+		ranges = append(ranges, languageRange{tag: tag, quality: quality})
+	}
+
+	// Ranges of equal quality keep the order of the header.
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	tags := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		tags = append(tags, r.tag)
+	}
+	return tags
+}
+
+// MatchLanguages returns the supported languages matching the given language
+// ranges, in order of preference and without duplicates. A range matches a
+// supported language either exactly or by its primary subtag, so fr-CA
+// matches fr. The wildcard range matches nothing, the fallback applies.
+func MatchLanguages(tags []string, isSupported func(string) bool) []string {
+	languages := make([]string, 0, len(tags))
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		candidates := []string{tag}
+		if base, _, ok := strings.Cut(tag, "-"); ok {
+			candidates = append(candidates, base)
+		}
+
+		for _, candidate := range candidates {
+			if seen[candidate] || !isSupported(candidate) {
+				continue
+			}
+			seen[candidate] = true
+			languages = append(languages, candidate)
+			break
+		}
+	}
+	return languages
+}
@@ -0,0 +1,52 @@
+package helpers
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []string
+	}{
+		{"", []string{}},
+		{"fr", []string{"fr"}},
+		{"FR-ca, en-US", []string{"fr-ca", "en-us"}},
+		{"en;q=0.5, fr;q=0.8, de", []string{"de", "fr", "en"}},
+		{"en;q=0.5, fr;q=0.5, de;q=0.5", []string{"en", "fr", "de"}},
+		{"fr ; q = 0.7 , en;q=0.9", []string{"en", "fr"}},
+		{"en;q=0, fr", []string{"fr"}},
+		{"en;q=1.5, fr;q=-1, de;q=abc, es", []string{"es"}},
+		{"fr;level=1;q=0.4, en;q=0.6", []string{"en", "fr"}},
+		{"*;q=0.1, fr", []string{"fr", "*"}},
+		{"*", []string{"*"}},
+		{",, ;q=0.5, fr", []string{"fr"}},
+		{"en us, fr_CA, <script>, fr-*, toolonglanguage, de-1996", []string{"de-1996"}},
+	}
+	for _, tt := range tests {
+		if got := ParseAcceptLanguage(tt.header); !slices.Equal(got, tt.want) {
+			t.Errorf("ParseAcceptLanguage(%q) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestMatchLanguages(t *testing.T) {
+	isSupported := func(lang string) bool { return lang == "en" || lang == "fr" }
+
+	tests := []struct {
+		tags []string
+		want []string
+	}{
+		{[]string{"fr-ca", "en"}, []string{"fr", "en"}},
+		{[]string{"fr", "fr-ca", "fr-be"}, []string{"fr"}},
+		{[]string{"de", "en-gb"}, []string{"en"}},
+		{[]string{"*"}, []string{}},
+		{[]string{"de", "es"}, []string{}},
+	}
+	for _, tt := range tests {
+		if got := MatchLanguages(tt.tags, isSupported); !slices.Equal(got, tt.want) {
+			t.Errorf("MatchLanguages(%q) = %q, want %q", tt.tags, got, tt.want)
+		}
+	}
+}
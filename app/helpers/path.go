@@ -0,0 +1,102 @@
+package helpers
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// NormalizePath returns the decoded path of a page, without query or
+// fragment, so that a path designates the same page whether it is
+// percent-encoded, as in a Referer, or not. An encoded slash is a slash. It
+// returns false when the path is not absolute or has an invalid escape.
+func NormalizePath(path string) (string, bool) {
+	path, _, _ = strings.Cut(path, "#")
+	path, _, _ = strings.Cut(path, "?")
+	if !strings.HasPrefix(path, "/") {
+		return "", false
+	}
+	decoded, err := url.PathUnescape(path)
+	if err != nil {
+		return "", false
+	}
+	return decoded, true
+}
+
+// ParsePathPatterns splits a list of page path patterns separated by new
+// lines or commas.
+func ParsePathPatterns(list string) []string {
+	return strings.FieldsFunc(list, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r' || r == ' '
+	})
+}
+
+// IsValidPathPattern reports whether pattern is an absolute page path, where
+// * matches any sequence of characters.
+func IsValidPathPattern(pattern string) bool {
+	return strings.HasPrefix(pattern, "/") && !strings.ContainsAny(pattern, "?#")
+}
+
+// compilePathPattern converts a path pattern to a regular expression. A
+// trailing /* also matches the path without it, so /checkout/* matches
+// /checkout as well as /checkout/payment. Patterns are normalized like the
+// paths they match.
+func compilePathPattern(pattern string) *regexp.Regexp {
+	if normalized, ok := NormalizePath(pattern); ok {
+		pattern = normalized
+	}
+	suffix := ""
+	if trimmed, ok := strings.CutSuffix(pattern, "/*"); ok {
+		pattern = trimmed
+		suffix = "(/.*)?"
+	}
+	expr := strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*")
+	return regexp.MustCompile("^" + expr + suffix + "$")
+}
+
+// PathTargeting restricts a message to the pages of a website matching one of
+// its include patterns, if any, and none of its exclude patterns.
+type PathTargeting struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// NewPathTargeting compiles lists of include and exclude patterns. Invalid
+// patterns are skipped.
+func NewPathTargeting(include string, exclude string) *PathTargeting {
+	targeting := &PathTargeting{}
+	for _, pattern := range ParsePathPatterns(include) {
+		if IsValidPathPattern(pattern) {
+			targeting.include = append(targeting.include, compilePathPattern(pattern))
+		}
+	}
+	for _, pattern := range ParsePathPatterns(exclude) {
+		if IsValidPathPattern(pattern) {
+			targeting.exclude = append(targeting.exclude, compilePathPattern(pattern))
+		}
+	}
+	return targeting
+}
+
+// Matches reports whether a message should be displayed on a page. When the
+// path is unknown, messages restricted to some pages are not displayed.
+func (t *PathTargeting) Matches(path string) bool {
+	if path == "" {
+		return len(t.include) == 0
+	}
+
+	for _, re := range t.exclude {
+		if re.MatchString(path) {
+			return false
+		}
+	}
+	if len(t.include) == 0 {
+		return true
+	}
+	for _, re := range t.include {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
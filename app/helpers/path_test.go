@@ -0,0 +1,42 @@
+package helpers
+
+import "testing"
+
+func TestNormalizePath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+		ok   bool
+	}{
+		{"/café", "/café", true},
+		{"/caf%C3%A9", "/café", true},
+		{"/caf%c3%a9/menu?table=4#top", "/café/menu", true},
+		{"/menu%2Fdrinks", "/menu/drinks", true},
+		{"/100%25", "/100%", true},
+		{"/", "/", true},
+		{"/bad%zz", "", false},
+		{"menu", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := NormalizePath(tt.path)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("NormalizePath(%q) = %q, %v, want %q, %v", tt.path, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestPathTargetingNormalizesPatterns(t *testing.T) {
+	for _, include := range []string{"/café/*", "/caf%C3%A9/*"} {
+		targeting := NewPathTargeting(include, "")
+		for _, path := range []string{"/caf%C3%A9/menu", "/café/menu"} {
+			normalized, _ := NormalizePath(path)
+			if !targeting.Matches(normalized) {
+				t.Errorf("%s should match %s", include, path)
+			}
+		}
+		if targeting.Matches("/cafe/menu") {
+			t.Errorf("%s should not match /cafe/menu", include)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package helpers
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PreviewScope is what a preview token shows: a message, or a whole website
+// when WebsiteId is set, until ExpiresAt.
+type PreviewScope struct {
+	MessageId int64
+	WebsiteId int64
+	ExpiresAt time.Time
+}
+
+// signedValues returns the values signed by a preview token, binding its
+// random part to its scope.
+func (s *PreviewScope) signedValues(random string) []string {
+	return []string{
+		"preview",
+		random,
+		strconv.FormatInt(s.MessageId, 10),
+		strconv.FormatInt(s.WebsiteId, 10),
+		strconv.FormatInt(s.ExpiresAt.Unix(), 10),
+	}
+}
+
+// GeneratePreviewToken returns a new preview token for a scope, signed with
+// secret, along with the prefix displayed in the admin UI and the hash stored
+// in the database. The expiry is truncated to the second.
+func GeneratePreviewToken(secret string, scope *PreviewScope) (token string, prefix string, hash string, err error) {
+	if secret == "" {
+		return "", "", "", errors.New("no secret to sign preview tokens")
+	}
+
+	random, _, _, err := generateSecret(previewTokenPrefix)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	token = strings.Join([]string{
+		random,
+		strconv.FormatInt(scope.MessageId, 10),
+		strconv.FormatInt(scope.WebsiteId, 10),
+		strconv.FormatInt(scope.ExpiresAt.Unix(), 10),
+		Sign(secret, scope.signedValues(random)...),
+	}, ".")
+	return token, token[:apiKeyDisplayChars], HashAPIKey(token), nil
+}
+
+// ParsePreviewToken returns the scope of a preview token, and false when its
+// signature does not match.
+func ParsePreviewToken(secret string, token string) (*PreviewScope, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 5 || !strings.HasPrefix(parts[0], previewTokenPrefix) {
+		return nil, false
+	}
+
+	var values [3]int64
+	for i := range values {
+		value, err := strconv.ParseInt(parts[i+1], 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		values[i] = value
+	}
+
+	scope := &PreviewScope{
+		MessageId: values[0],
+		WebsiteId: values[1],
+		ExpiresAt: time.Unix(values[2], 0).UTC(),
+	}
+	if !VerifySignature(secret, parts[4], scope.signedValues(parts[0])...) {
+		return nil, false
+	}
+	return scope, true
+}
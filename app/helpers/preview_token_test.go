@@ -0,0 +1,70 @@
+package helpers
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestPreviewToken(t *testing.T) {
+	scope := &PreviewScope{MessageId: 7, WebsiteId: 3, ExpiresAt: time.Date(2024, 7, 1, 13, 0, 0, 0, time.UTC)}
+	token, prefix, hash, err := GeneratePreviewToken(testSecret, scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(token, prefix) || hash != HashAPIKey(token) {
+		t.Errorf("prefix %q and hash %q do not match %q", prefix, hash, token)
+	}
+
+	got, ok := ParsePreviewToken(testSecret, token)
+	if !ok {
+		t.Fatal("a generated token should parse")
+	}
+	if *got != *scope {
+		t.Errorf("ParsePreviewToken = %+v, want %+v", got, scope)
+	}
+
+	if _, _, _, err := GeneratePreviewToken("", scope); err == nil {
+		t.Error("tokens should not be generated without secret")
+	}
+}
+
+func TestParsePreviewTokenRejectsTamperedTokens(t *testing.T) {
+	scope := &PreviewScope{MessageId: 7, ExpiresAt: time.Date(2024, 7, 1, 13, 0, 0, 0, time.UTC)}
+	token, _, _, err := GeneratePreviewToken(testSecret, scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(token, ".")
+
+	tests := map[string]func([]string){
+		"random":    func(p []string) { p[0] = previewTokenPrefix + strings.Repeat("A", 43) },
+		"message":   func(p []string) { p[1] = "8" },
+		"website":   func(p []string) { p[2] = "3" },
+		"expiry":    func(p []string) { p[3] = "4102444800" },
+		"signature": func(p []string) { p[4] = strings.Repeat("A", len(p[4])) },
+	}
+	for name, tamper := range tests {
+		t.Run(name, func(t *testing.T) {
+			tampered := append([]string(nil), parts...)
+			tamper(tampered)
+			if scope, ok := ParsePreviewToken(testSecret, strings.Join(tampered, ".")); ok {
+				t.Errorf("a tampered token should be rejected, got %+v", scope)
+			}
+		})
+	}
+
+	for _, token := range []string{"", "msp_random", token + ".extra", strings.Replace(token, previewTokenPrefix, apiKeyPrefix, 1)} {
+		if _, ok := ParsePreviewToken(testSecret, token); ok {
+			t.Errorf("%q should be rejected", token)
+		}
+	}
+	if _, ok := ParsePreviewToken("another secret of thirty-two bytes", token); ok {
+		t.Error("a token signed with another secret should be rejected")
+	}
+	if _, ok := ParsePreviewToken("", token); ok {
+		t.Error("tokens should be rejected without secret")
+	}
+}
@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// DefaultURLSchemes are the URL schemes allowed in links and images unless a
+// website restricts them.
+var DefaultURLSchemes = []string{"http", "https", "mailto"}
+
+// SanitizePolicy restricts the HTML delivered to a website.
+type SanitizePolicy struct {
+	ForbidLinks  bool
+	ForbidImages bool
+	URLSchemes   []string
+}
+
+// sanitizeTextElements are the elements produced by the markdown renderer
+// that carry no URL.
+var sanitizeTextElements = []string{
+	"p", "br", "hr", "blockquote", "pre", "code", "span",
+	"h1", "h2", "h3", "h4", "h5", "h6",
+	"strong", "b", "em", "i", "u", "s", "del", "ins", "mark", "sub", "sup",
+	"ul", "ol", "li", "dl", "dt", "dd",
+	"table", "thead", "tbody", "tfoot", "tr", "th", "td",
+}
+
+// NewSanitizer returns the allow-list policy of the given restrictions.
+func NewSanitizer(policy SanitizePolicy) *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+	p.AllowElements(sanitizeTextElements...)
+	p.AllowAttrs("id").Matching(bluemonday.Paragraph).OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	p.AllowAttrs("align").Matching(regexp.MustCompile(`^(left|center|right)$`)).OnElements("th", "td")
+	p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[a-zA-Z0-9_+-]+$`)).OnElements("code")
+
+	schemes := policy.URLSchemes
+	if len(schemes) == 0 {
+		schemes = DefaultURLSchemes
+	}
+	p.RequireParseableURLs(true)
+	p.AllowURLSchemes(schemes...)
+
+	if !policy.ForbidLinks {
+		p.AllowAttrs("href").OnElements("a")
+		p.AllowAttrs("title").Matching(bluemonday.Paragraph).OnElements("a")
+		p.AddTargetBlankToFullyQualifiedLinks(true)
+		p.RequireNoReferrerOnFullyQualifiedLinks(true)
+	}
+	if !policy.ForbidImages {
+		p.AllowAttrs("src").OnElements("img")
+		p.AllowAttrs("alt", "title").Matching(bluemonday.Paragraph).OnElements("img")
+	}
+
+	return p
+}
+
+// SanitizeHTML removes from html every element, attribute and URL the policy
+// does not allow. Forbidden links are replaced by their text.
+func SanitizeHTML(html []byte, policy SanitizePolicy) []byte {
+	return NewSanitizer(policy).SanitizeBytes(html)
+}
+
+// urlSchemeRegexp matches a URL scheme as defined by RFC 3986.
+var urlSchemeRegexp = regexp.MustCompile(`^[a-z][a-z0-9+.-]*$`)
+
+// ParseURLSchemes splits a comma separated list of URL schemes. It returns
+// false when one of them is not a valid scheme.
+func ParseURLSchemes(list string) ([]string, bool) {
+	schemes := make([]string, 0)
+	for _, scheme := range strings.Split(list, ",") {
+		scheme = strings.ToLower(strings.TrimSpace(scheme))
+		if scheme == "" {
+			continue
+		}
+		if !urlSchemeRegexp.MatchString(scheme) {
+			return nil, false
+		}
+		schemes = append(schemes, scheme)
+	}
+	return schemes, true
+}
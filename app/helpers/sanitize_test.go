@@ -0,0 +1,106 @@
+package helpers
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSanitizeHTML(t *testing.T) {
+	tests := []struct {
+		name   string
+		html   string
+		policy SanitizePolicy
+		want   string
+	}{
+		{
+			name: "text elements are kept",
+			html: `<p><strong>Bold</strong> and <em>italic</em></p>`,
+			want: `<p><strong>Bold</strong> and <em>italic</em></p>`,
+		},
+		{
+			name: "scripts are removed",
+			html: `<p>Hello</p><script>alert(1)</script>`,
+			want: `<p>Hello</p>`,
+		},
+		{
+			name: "event handlers are removed",
+			html: `<p onclick="alert(1)" onmouseover="alert(2)">Hello</p>`,
+			want: `<p>Hello</p>`,
+		},
+		{
+			name: "javascript links are removed",
+			html: `<a href="javascript:alert(1)">Click</a>`,
+			want: `Click`,
+		},
+		{
+			name: "obfuscated javascript links are removed",
+			html: `<a href="JaVaScRiPt:alert(1)">Click</a><a href="java&#x09;script:alert(1)">Here</a>`,
+			want: `ClickHere`,
+		},
+		{
+			name: "javascript images are removed",
+			html: `<img src="javascript:alert(1)" onerror="alert(2)">`,
+			want: ``,
+		},
+		{
+			name: "styles and iframes are removed",
+			html: `<style>p{}</style><iframe src="https://example.com"></iframe><p style="color:red">Hello</p>`,
+			want: `<p>Hello</p>`,
+		},
+		{
+			name: "external links open in a new tab without referrer",
+			html: `<a href="https://example.com" title="Example">Example</a>`,
+			want: `<a href="https://example.com" title="Example" rel="noreferrer noopener" target="_blank">Example</a>`,
+		},
+		{
+			name: "mailto links are allowed by default",
+			html: `<a href="mailto:info@example.com">Mail</a>`,
+			want: `<a href="mailto:info@example.com">Mail</a>`,
+		},
+		{
+			name:   "forbidden links are replaced by their text",
+			html:   `<p>Read <a href="https://example.com">more</a></p>`,
+			policy: SanitizePolicy{ForbidLinks: true},
+			want:   `<p>Read more</p>`,
+		},
+		{
+			name:   "forbidden images are removed",
+			html:   `<p><img src="https://example.com/a.png" alt="A">Text</p>`,
+			policy: SanitizePolicy{ForbidImages: true},
+			want:   `<p>Text</p>`,
+		},
+		{
+			name:   "schemes outside of the policy are removed",
+			html:   `<a href="mailto:info@example.com">Mail</a> <a href="https://example.com">Web</a>`,
+			policy: SanitizePolicy{URLSchemes: []string{"https"}},
+			want:   `Mail <a href="https://example.com" rel="noreferrer noopener" target="_blank">Web</a>`,
+		},
+		{
+			name: "code classes are restricted to languages",
+			html: `<code class="language-go">x</code><code class="evil">y</code>`,
+			want: `<code class="language-go">x</code><code>y</code>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(SanitizeHTML([]byte(tt.html), tt.policy)); got != tt.want {
+				t.Errorf("SanitizeHTML(%s)\n got %s\nwant %s", tt.html, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseURLSchemes(t *testing.T) {
+	schemes, ok := ParseURLSchemes(" HTTPS, mailto,,tel ")
+	if !ok || !slices.Equal(schemes, []string{"https", "mailto", "tel"}) {
+		t.Errorf("ParseURLSchemes = %q, %t", schemes, ok)
+	}
+	for _, list := range []string{"https, java script", "1http", "http:"} {
+		if _, ok := ParseURLSchemes(list); ok {
+			t.Errorf("%q should not parse", list)
+		}
+	}
+	if schemes, ok := ParseURLSchemes(""); !ok || len(schemes) != 0 {
+		t.Errorf("an empty list should parse as no scheme, got %q", schemes)
+	}
+}
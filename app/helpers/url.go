@@ -1,8 +1,14 @@
 package helpers
 
 import (
+	"net"
+	"net/http"
+	"net/url"
 	"regexp"
+	"strconv"
+	"strings"
 
+	"github.com/anthdm/superkit/kit"
 	"github.com/anthdm/superkit/validate"
 )
 
@@ -25,3 +31,91 @@ var ValidDomain = validate.RuleSet{
 		return IsValidDomain(str)
 	},
 }
+
+// AppURL returns the public URL of the application set by the APP_URL
+// environment variable, empty when it is not set.
+func AppURL() string {
+	return strings.TrimRight(kit.Getenv("APP_URL", ""), "/")
+}
+
+// BaseURL returns the public URL of the application, from the APP_URL
+// environment variable or else from the request.
+func BaseURL(r *http.Request) string {
+	if appURL := AppURL(); appURL != "" {
+		return appURL
+	}
+
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// NormalizeOrigin returns the serialization of a web origin (e.g.
+// https://example.com:8443), lowercased. It returns false when origin is not
+// an http or https URL without path, query or fragment.
+func NormalizeOrigin(origin string) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(origin))
+	if err != nil || u.Host == "" || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return "", false
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", false
+	}
+	if u.Path != "" && u.Path != "/" {
+		return "", false
+	}
+	return strings.ToLower(u.Scheme + "://" + u.Host), true
+}
+
+// ClientIP returns the IP address of the client of a request. The
+// X-Forwarded-For and X-Real-IP headers are only trusted when TRUST_PROXY is
+// set, as clients can set them freely.
+func ClientIP(r *http.Request) string {
+	return clientIP(r, trustedProxies())
+}
+
+// trustedProxies returns the number of reverse proxies in front of the
+// application: TRUST_PROXY is true for one, or a number of proxies.
+func trustedProxies() int {
+	value := kit.Getenv("TRUST_PROXY", "false")
+	if value == "true" {
+		return 1
+	}
+	proxies, err := strconv.Atoi(value)
+	if err != nil || proxies < 0 {
+		return 0
+	}
+	return proxies
+}
+
+// clientIP returns the IP address of the client of a request behind a number
+// of trusted proxies. Each proxy appends the address it received the request
+// from to X-Forwarded-For, so the client is the entry that many places from
+// the right: the entries on its left are written by the client.
+func clientIP(r *http.Request, proxies int) string {
+	if proxies > 0 {
+		var forwarded []string
+		for _, header := range r.Header.Values("X-Forwarded-For") {
+			for _, entry := range strings.Split(header, ",") {
+				forwarded = append(forwarded, strings.TrimSpace(entry))
+			}
+		}
+		if len(forwarded) > 0 {
+			// A shorter chain was appended by the innermost proxies only.
+			entry := forwarded[max(len(forwarded)-proxies, 0)]
+			if ip := net.ParseIP(entry); ip != nil {
+				return ip.String()
+			}
+		} else if ip := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); ip != nil {
+			return ip.String()
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,58 @@
+package helpers
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name      string
+		proxies   int
+		forwarded []string
+		realIP    string
+		want      string
+	}{
+		{"untrusted headers", 0, []string{"198.51.100.7"}, "198.51.100.8", "192.0.2.1"},
+		{"one proxy", 1, []string{"198.51.100.7"}, "", "198.51.100.7"},
+		{"spoofed entry", 1, []string{"203.0.113.9, 198.51.100.7"}, "", "198.51.100.7"},
+		{"spoofed header", 1, []string{"203.0.113.9", "198.51.100.7"}, "", "198.51.100.7"},
+		{"two proxies", 2, []string{"203.0.113.9, 198.51.100.7, 10.0.0.2"}, "", "198.51.100.7"},
+		{"shorter chain", 2, []string{"198.51.100.7"}, "", "198.51.100.7"},
+		{"invalid entry", 1, []string{"198.51.100.7, not-an-ip"}, "", "192.0.2.1"},
+		{"real IP", 1, nil, "198.51.100.8", "198.51.100.8"},
+		{"IPv6", 1, []string{"2001:DB8::1"}, "", "2001:db8::1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/api/v1/messages", nil)
+			r.RemoteAddr = "192.0.2.1:51234"
+			for _, forwarded := range tt.forwarded {
+				r.Header.Add("X-Forwarded-For", forwarded)
+			}
+			if tt.realIP != "" {
+				r.Header.Set("X-Real-IP", tt.realIP)
+			}
+			if got := clientIP(r, tt.proxies); got != tt.want {
+				t.Errorf("clientIP = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestClientIPIgnoresSpoofedEntries checks that a client sending a new
+// X-Forwarded-For entry on every request keeps the IP address keying its rate
+// limit bucket.
+func TestClientIPIgnoresSpoofedEntries(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, spoofed := range []string{"203.0.113.1", "203.0.113.2", "2001:db8::3", "garbage"} {
+		r := httptest.NewRequest("GET", "/api/v1/messages", nil)
+		r.RemoteAddr = "10.0.0.2:443"
+		r.Header.Set("X-Forwarded-For", spoofed+", 198.51.100.7")
+		seen[clientIP(r, 1)] = true
+	}
+	if len(seen) != 1 || !seen["198.51.100.7"] {
+		t.Errorf("client IPs = %v, want only the address appended by the proxy", seen)
+	}
+}
@@ -0,0 +1,102 @@
+// Package links routes the links of messages through a redirect endpoint
+// counting their clicks. Tracked links are signed, so the endpoint cannot be
+// used as an open redirect.
+package links
+
+import (
+	"fmt"
+	"messages/app/helpers"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Path is the path of the redirect endpoint of tracked links.
+const Path = "/api/v1/messages/link"
+
+// maxLength bounds the length of the destinations of tracked links.
+const maxLength = 2048
+
+// Link is a tracked link of a message on a website.
+type Link struct {
+	MessageId   int64
+	WebsiteId   int64
+	Destination string
+}
+
+// signedValues returns the values signed by the signature of a link, binding
+// its destination to its message and its website.
+func (l *Link) signedValues() []string {
+	return []string{"link", strconv.FormatInt(l.MessageId, 10), strconv.FormatInt(l.WebsiteId, 10), l.Destination}
+}
+
+// isTrackable reports whether a destination can be tracked: an http or https
+// URL of a reasonable length.
+func isTrackable(destination string) bool {
+	u, err := url.Parse(destination)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && len(destination) <= maxLength
+}
+
+// Rewriter returns a function replacing the http and https links of a
+// message on a website by tracked links under appURL, the public URL of the
+// application. It returns nil, and links are not tracked, without appURL or
+// secret.
+func Rewriter(appURL string, secret string, messageId int64, websiteId int64) func(string) string {
+	if appURL == "" || secret == "" {
+		return nil
+	}
+
+	return func(destination string) string {
+		if !isTrackable(destination) {
+			return destination
+		}
+
+		link := &Link{MessageId: messageId, WebsiteId: websiteId, Destination: destination}
+		query := url.Values{}
+		query.Set("m", strconv.FormatInt(link.MessageId, 10))
+		query.Set("w", strconv.FormatInt(link.WebsiteId, 10))
+		query.Set("u", link.Destination)
+		query.Set("s", helpers.Sign(secret, link.signedValues()...))
+		return appURL + Path + "?" + query.Encode()
+	}
+}
+
+// Parse returns the link of the query of a tracked link, and false when its
+// signature does not match.
+func Parse(secret string, query url.Values) (*Link, bool) {
+	messageId, err := strconv.ParseInt(query.Get("m"), 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	websiteId, err := strconv.ParseInt(query.Get("w"), 10, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	link := &Link{MessageId: messageId, WebsiteId: websiteId, Destination: query.Get("u")}
+	if !helpers.VerifySignature(secret, query.Get("s"), link.signedValues()...) || !isTrackable(link.Destination) {
+		return nil, false
+	}
+	return link, true
+}
+
+// Redirect answers a request to a tracked link with a redirect to its
+// destination, after calling record when tracked reports that its website
+// still tracks its links. Links whose signature does not match are refused.
+func Redirect(w http.ResponseWriter, r *http.Request, secret string, tracked func(websiteId int64) bool, record func(*Link)) {
+	link, ok := Parse(secret, r.URL.Query())
+	if !ok {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, "Invalid link")
+		return
+	}
+
+	if tracked(link.WebsiteId) {
+		record(link)
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	http.Redirect(w, r, link.Destination, http.StatusFound)
+}
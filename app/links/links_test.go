@@ -0,0 +1,127 @@
+package links
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const (
+	testAppURL = "https://messages.example.org"
+	testSecret = "0123456789abcdef0123456789abcdef"
+)
+
+// trackedQuery returns the query of the tracked link of a destination.
+func trackedQuery(t *testing.T, destination string) url.Values {
+	t.Helper()
+	tracked := Rewriter(testAppURL, testSecret, 7, 3)(destination)
+	prefix := testAppURL + Path + "?"
+	if !strings.HasPrefix(tracked, prefix) {
+		t.Fatalf("%s is not tracked: %s", destination, tracked)
+	}
+	query, err := url.ParseQuery(strings.TrimPrefix(tracked, prefix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return query
+}
+
+func TestRewriter(t *testing.T) {
+	if Rewriter("", testSecret, 7, 3) != nil {
+		t.Error("links should not be tracked without the URL of the application")
+	}
+	if Rewriter(testAppURL, "", 7, 3) != nil {
+		t.Error("links should not be tracked without secret")
+	}
+
+	rewrite := Rewriter(testAppURL, testSecret, 7, 3)
+	for _, destination := range []string{"mailto:info@example.com", "/relative", "javascript:alert(1)", "https://example.com/" + strings.Repeat("a", maxLength)} {
+		if got := rewrite(destination); got != destination {
+			t.Errorf("%.40s should be kept, got %.80s", destination, got)
+		}
+	}
+
+	query := trackedQuery(t, "https://example.com/offer?a=1&b=2")
+	link, ok := Parse(testSecret, query)
+	if !ok {
+		t.Fatal("a tracked link should parse")
+	}
+	if *link != (Link{MessageId: 7, WebsiteId: 3, Destination: "https://example.com/offer?a=1&b=2"}) {
+		t.Errorf("Parse = %+v", link)
+	}
+}
+
+func TestParseRejectsTamperedLinks(t *testing.T) {
+	tests := map[string]func(url.Values){
+		"destination": func(q url.Values) { q.Set("u", "https://evil.example.com") },
+		"destination suffix": func(q url.Values) {
+			q.Set("u", q.Get("u")+"/../redirect")
+		},
+		"message":           func(q url.Values) { q.Set("m", "8") },
+		"website":           func(q url.Values) { q.Set("w", "4") },
+		"signature":         func(q url.Values) { q.Set("s", strings.Repeat("A", len(q.Get("s")))) },
+		"missing signature": func(q url.Values) { q.Del("s") },
+		"invalid message":   func(q url.Values) { q.Set("m", "seven") },
+	}
+	for name, tamper := range tests {
+		t.Run(name, func(t *testing.T) {
+			query := trackedQuery(t, "https://example.com")
+			tamper(query)
+			if link, ok := Parse(testSecret, query); ok {
+				t.Errorf("a tampered link should be rejected, got %+v", link)
+			}
+		})
+	}
+
+	query := trackedQuery(t, "https://example.com")
+	if _, ok := Parse("another secret of thirty-two bytes", query); ok {
+		t.Error("a link signed with another secret should be rejected")
+	}
+	if _, ok := Parse("", query); ok {
+		t.Error("links should be rejected without secret")
+	}
+}
+
+func TestRedirect(t *testing.T) {
+	tracking := map[int64]bool{3: true}
+	tracked := func(websiteId int64) bool { return tracking[websiteId] }
+
+	var clicks []*Link
+	record := func(link *Link) { clicks = append(clicks, link) }
+
+	serve := func(query url.Values) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, Path+"?"+query.Encode(), nil)
+		Redirect(w, r, testSecret, tracked, record)
+		return w
+	}
+
+	query := trackedQuery(t, "https://example.com/offer")
+	w := serve(query)
+	if w.Code != http.StatusFound || w.Header().Get("Location") != "https://example.com/offer" {
+		t.Fatalf("got %d to %q, want a redirect to the destination", w.Code, w.Header().Get("Location"))
+	}
+	if w.Header().Get("Referrer-Policy") != "no-referrer" {
+		t.Error("the redirect should not leak the referrer")
+	}
+	if len(clicks) != 1 || clicks[0].MessageId != 7 || clicks[0].WebsiteId != 3 {
+		t.Fatalf("clicks = %v, want the click on the link", clicks)
+	}
+
+	// Links already published keep redirecting once tracking is disabled,
+	// without counting clicks.
+	tracking[3] = false
+	if w := serve(query); w.Code != http.StatusFound {
+		t.Errorf("got %d, want a redirect", w.Code)
+	}
+	if len(clicks) != 1 {
+		t.Errorf("%d clicks, want the click counted while tracking", len(clicks))
+	}
+
+	query.Set("u", "https://evil.example.com")
+	if w := serve(query); w.Code != http.StatusBadRequest || w.Header().Get("Location") != "" {
+		t.Errorf("got %d to %q, want a 400 without redirect", w.Code, w.Header().Get("Location"))
+	}
+}
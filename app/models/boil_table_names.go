@@ -4,19 +4,35 @@
 package models
 
 var TableNames = struct {
-	GooseDBVersion   string
-	Invitation       string
-	Messages         string
-	Sessions         string
-	Users            string
-	Websites         string
-	WebsitesMessages string
+	GooseDBVersion      string
+	Invitation          string
+	Languages           string
+	MessageLinkClicks   string
+	MessageStats        string
+	MessageTranslations string
+	Messages            string
+	PreviewTokens       string
+	Sessions            string
+	Users               string
+	WebsiteAPIKeys      string
+	WebsiteDomains      string
+	WebsiteSlots        string
+	Websites            string
+	WebsitesMessages    string
 }{
-	GooseDBVersion:   "goose_db_version",
-	Invitation:       "invitation",
-	Messages:         "messages",
-	Sessions:         "sessions",
-	Users:            "users",
-	Websites:         "websites",
-	WebsitesMessages: "websites_messages",
+	GooseDBVersion:      "goose_db_version",
+	Invitation:          "invitation",
+	Languages:           "languages",
+	MessageLinkClicks:   "message_link_clicks",
+	MessageStats:        "message_stats",
+	MessageTranslations: "message_translations",
+	Messages:            "messages",
+	PreviewTokens:       "preview_tokens",
+	Sessions:            "sessions",
+	Users:               "users",
+	WebsiteAPIKeys:      "website_api_keys",
+	WebsiteDomains:      "website_domains",
+	WebsiteSlots:        "website_slots",
+	Websites:            "websites",
+	WebsitesMessages:    "websites_messages",
 }
@@ -0,0 +1,1330 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MessageLinkClick is an object representing the database table.
+type MessageLinkClick struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	MessageID int64     `boil:"message_id" json:"message_id" toml:"message_id" yaml:"message_id"`
+	WebsiteID int64     `boil:"website_id" json:"website_id" toml:"website_id" yaml:"website_id"`
+	URL       string    `boil:"url" json:"url" toml:"url" yaml:"url"`
+	Hour      time.Time `boil:"hour" json:"hour" toml:"hour" yaml:"hour"`
+	Clicks    int64     `boil:"clicks" json:"clicks" toml:"clicks" yaml:"clicks"`
+
+	R *messageLinkClickR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L messageLinkClickL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MessageLinkClickColumns = struct {
+	ID        string
+	MessageID string
+	WebsiteID string
+	URL       string
+	Hour      string
+	Clicks    string
+}{
+	ID:        "id",
+	MessageID: "message_id",
+	WebsiteID: "website_id",
+	URL:       "url",
+	Hour:      "hour",
+	Clicks:    "clicks",
+}
+
+var MessageLinkClickTableColumns = struct {
+	ID        string
+	MessageID string
+	WebsiteID string
+	URL       string
+	Hour      string
+	Clicks    string
+}{
+	ID:        "message_link_clicks.id",
+	MessageID: "message_link_clicks.message_id",
+	WebsiteID: "message_link_clicks.website_id",
+	URL:       "message_link_clicks.url",
+	Hour:      "message_link_clicks.hour",
+	Clicks:    "message_link_clicks.clicks",
+}
+
+// Generated where
+
+var MessageLinkClickWhere = struct {
+	ID        whereHelperint64
+	MessageID whereHelperint64
+	WebsiteID whereHelperint64
+	URL       whereHelperstring
+	Hour      whereHelpertime_Time
+	Clicks    whereHelperint64
+}{
+	ID:        whereHelperint64{field: "\"message_link_clicks\".\"id\""},
+	MessageID: whereHelperint64{field: "\"message_link_clicks\".\"message_id\""},
+	WebsiteID: whereHelperint64{field: "\"message_link_clicks\".\"website_id\""},
+	URL:       whereHelperstring{field: "\"message_link_clicks\".\"url\""},
+	Hour:      whereHelpertime_Time{field: "\"message_link_clicks\".\"hour\""},
+	Clicks:    whereHelperint64{field: "\"message_link_clicks\".\"clicks\""},
+}
+
+// MessageLinkClickRels is where relationship names are stored.
+var MessageLinkClickRels = struct {
+	Website string
+	Message string
+}{
+	Website: "Website",
+	Message: "Message",
+}
+
+// messageLinkClickR is where relationships are stored.
+type messageLinkClickR struct {
+	Website *Website `boil:"Website" json:"Website" toml:"Website" yaml:"Website"`
+	Message *Message `boil:"Message" json:"Message" toml:"Message" yaml:"Message"`
+}
+
+// NewStruct creates a new relationship struct
+func (*messageLinkClickR) NewStruct() *messageLinkClickR {
+	return &messageLinkClickR{}
+}
+
+func (r *messageLinkClickR) GetWebsite() *Website {
+	if r == nil {
+		return nil
+	}
+	return r.Website
+}
+
+func (r *messageLinkClickR) GetMessage() *Message {
+	if r == nil {
+		return nil
+	}
+	return r.Message
+}
+
+// messageLinkClickL is where Load methods for each relationship are stored.
+type messageLinkClickL struct{}
+
+var (
+	messageLinkClickAllColumns            = []string{"id", "message_id", "website_id", "url", "hour", "clicks"}
+	messageLinkClickColumnsWithoutDefault = []string{"message_id", "website_id", "url", "hour"}
+	messageLinkClickColumnsWithDefault    = []string{"id", "clicks"}
+	messageLinkClickPrimaryKeyColumns     = []string{"id"}
+	messageLinkClickGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// MessageLinkClickSlice is an alias for a slice of pointers to MessageLinkClick.
+	// This should almost always be used instead of []MessageLinkClick.
+	MessageLinkClickSlice []*MessageLinkClick
+	// MessageLinkClickHook is the signature for custom MessageLinkClick hook methods
+	MessageLinkClickHook func(context.Context, boil.ContextExecutor, *MessageLinkClick) error
+
+	messageLinkClickQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	messageLinkClickType                 = reflect.TypeOf(&MessageLinkClick{})
+	messageLinkClickMapping              = queries.MakeStructMapping(messageLinkClickType)
+	messageLinkClickPrimaryKeyMapping, _ = queries.BindMapping(messageLinkClickType, messageLinkClickMapping, messageLinkClickPrimaryKeyColumns)
+	messageLinkClickInsertCacheMut       sync.RWMutex
+	messageLinkClickInsertCache          = make(map[string]insertCache)
+	messageLinkClickUpdateCacheMut       sync.RWMutex
+	messageLinkClickUpdateCache          = make(map[string]updateCache)
+	messageLinkClickUpsertCacheMut       sync.RWMutex
+	messageLinkClickUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var messageLinkClickAfterSelectMu sync.Mutex
+var messageLinkClickAfterSelectHooks []MessageLinkClickHook
+
+var messageLinkClickBeforeInsertMu sync.Mutex
+var messageLinkClickBeforeInsertHooks []MessageLinkClickHook
+var messageLinkClickAfterInsertMu sync.Mutex
+var messageLinkClickAfterInsertHooks []MessageLinkClickHook
+
+var messageLinkClickBeforeUpdateMu sync.Mutex
+var messageLinkClickBeforeUpdateHooks []MessageLinkClickHook
+var messageLinkClickAfterUpdateMu sync.Mutex
+var messageLinkClickAfterUpdateHooks []MessageLinkClickHook
+
+var messageLinkClickBeforeDeleteMu sync.Mutex
+var messageLinkClickBeforeDeleteHooks []MessageLinkClickHook
+var messageLinkClickAfterDeleteMu sync.Mutex
+var messageLinkClickAfterDeleteHooks []MessageLinkClickHook
+
+var messageLinkClickBeforeUpsertMu sync.Mutex
+var messageLinkClickBeforeUpsertHooks []MessageLinkClickHook
+var messageLinkClickAfterUpsertMu sync.Mutex
+var messageLinkClickAfterUpsertHooks []MessageLinkClickHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MessageLinkClick) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageLinkClickAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MessageLinkClick) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageLinkClickBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MessageLinkClick) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageLinkClickAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MessageLinkClick) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageLinkClickBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MessageLinkClick) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageLinkClickAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MessageLinkClick) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageLinkClickBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MessageLinkClick) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageLinkClickAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MessageLinkClick) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageLinkClickBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MessageLinkClick) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageLinkClickAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMessageLinkClickHook registers your hook function for all future operations.
+func AddMessageLinkClickHook(hookPoint boil.HookPoint, messageLinkClickHook MessageLinkClickHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		messageLinkClickAfterSelectMu.Lock()
+		messageLinkClickAfterSelectHooks = append(messageLinkClickAfterSelectHooks, messageLinkClickHook)
+		messageLinkClickAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		messageLinkClickBeforeInsertMu.Lock()
+		messageLinkClickBeforeInsertHooks = append(messageLinkClickBeforeInsertHooks, messageLinkClickHook)
+		messageLinkClickBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		messageLinkClickAfterInsertMu.Lock()
+		messageLinkClickAfterInsertHooks = append(messageLinkClickAfterInsertHooks, messageLinkClickHook)
+		messageLinkClickAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		messageLinkClickBeforeUpdateMu.Lock()
+		messageLinkClickBeforeUpdateHooks = append(messageLinkClickBeforeUpdateHooks, messageLinkClickHook)
+		messageLinkClickBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		messageLinkClickAfterUpdateMu.Lock()
+		messageLinkClickAfterUpdateHooks = append(messageLinkClickAfterUpdateHooks, messageLinkClickHook)
+		messageLinkClickAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		messageLinkClickBeforeDeleteMu.Lock()
+		messageLinkClickBeforeDeleteHooks = append(messageLinkClickBeforeDeleteHooks, messageLinkClickHook)
+		messageLinkClickBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		messageLinkClickAfterDeleteMu.Lock()
+		messageLinkClickAfterDeleteHooks = append(messageLinkClickAfterDeleteHooks, messageLinkClickHook)
+		messageLinkClickAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		messageLinkClickBeforeUpsertMu.Lock()
+		messageLinkClickBeforeUpsertHooks = append(messageLinkClickBeforeUpsertHooks, messageLinkClickHook)
+		messageLinkClickBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		messageLinkClickAfterUpsertMu.Lock()
+		messageLinkClickAfterUpsertHooks = append(messageLinkClickAfterUpsertHooks, messageLinkClickHook)
+		messageLinkClickAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single messageLinkClick record from the query.
+func (q messageLinkClickQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MessageLinkClick, error) {
+	o := &MessageLinkClick{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for message_link_clicks")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MessageLinkClick records from the query.
+func (q messageLinkClickQuery) All(ctx context.Context, exec boil.ContextExecutor) (MessageLinkClickSlice, error) {
+	var o []*MessageLinkClick
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MessageLinkClick slice")
+	}
+
+	if len(messageLinkClickAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MessageLinkClick records in the query.
+func (q messageLinkClickQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count message_link_clicks rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q messageLinkClickQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if message_link_clicks exists")
+	}
+
+	return count > 0, nil
+}
+
+// Website pointed to by the foreign key.
+func (o *MessageLinkClick) Website(mods ...qm.QueryMod) websiteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WebsiteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Websites(queryMods...)
+}
+
+// Message pointed to by the foreign key.
+func (o *MessageLinkClick) Message(mods ...qm.QueryMod) messageQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.MessageID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Messages(queryMods...)
+}
+
+// LoadWebsite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (messageLinkClickL) LoadWebsite(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMessageLinkClick interface{}, mods queries.Applicator) error {
+	var slice []*MessageLinkClick
+	var object *MessageLinkClick
+
+	if singular {
+		var ok bool
+		object, ok = maybeMessageLinkClick.(*MessageLinkClick)
+		if !ok {
+			object = new(MessageLinkClick)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMessageLinkClick)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMessageLinkClick))
+			}
+		}
+	} else {
+		s, ok := maybeMessageLinkClick.(*[]*MessageLinkClick)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMessageLinkClick)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMessageLinkClick))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &messageLinkClickR{}
+		}
+		args[object.WebsiteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &messageLinkClickR{}
+			}
+
+			args[obj.WebsiteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites`),
+		qm.WhereIn(`websites.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Website")
+	}
+
+	var resultSlice []*Website
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Website")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for websites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
+	}
+
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Website = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteR{}
+		}
+		foreign.R.MessageLinkClicks = append(foreign.R.MessageLinkClicks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebsiteID == foreign.ID {
+				local.R.Website = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.MessageLinkClicks = append(foreign.R.MessageLinkClicks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMessage allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (messageLinkClickL) LoadMessage(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMessageLinkClick interface{}, mods queries.Applicator) error {
+	var slice []*MessageLinkClick
+	var object *MessageLinkClick
+
+	if singular {
+		var ok bool
+		object, ok = maybeMessageLinkClick.(*MessageLinkClick)
+		if !ok {
+			object = new(MessageLinkClick)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMessageLinkClick)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMessageLinkClick))
+			}
+		}
+	} else {
+		s, ok := maybeMessageLinkClick.(*[]*MessageLinkClick)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMessageLinkClick)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMessageLinkClick))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &messageLinkClickR{}
+		}
+		args[object.MessageID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &messageLinkClickR{}
+			}
+
+			args[obj.MessageID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`messages`),
+		qm.WhereIn(`messages.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Message")
+	}
+
+	var resultSlice []*Message
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Message")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for messages")
+	}
+
+	if len(messageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Message = foreign
+		if foreign.R == nil {
+			foreign.R = &messageR{}
+		}
+		foreign.R.MessageLinkClicks = append(foreign.R.MessageLinkClicks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.MessageID == foreign.ID {
+				local.R.Message = foreign
+				if foreign.R == nil {
+					foreign.R = &messageR{}
+				}
+				foreign.R.MessageLinkClicks = append(foreign.R.MessageLinkClicks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebsite of the messageLinkClick to the related item.
+// Sets o.R.Website to related.
+// Adds o to related.R.MessageLinkClicks.
+func (o *MessageLinkClick) SetWebsite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Website) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"message_link_clicks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"website_id"}),
+		strmangle.WhereClause("\"", "\"", 0, messageLinkClickPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebsiteID = related.ID
+	if o.R == nil {
+		o.R = &messageLinkClickR{
+			Website: related,
+		}
+	} else {
+		o.R.Website = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteR{
+			MessageLinkClicks: MessageLinkClickSlice{o},
+		}
+	} else {
+		related.R.MessageLinkClicks = append(related.R.MessageLinkClicks, o)
+	}
+
+	return nil
+}
+
+// SetMessage of the messageLinkClick to the related item.
+// Sets o.R.Message to related.
+// Adds o to related.R.MessageLinkClicks.
+func (o *MessageLinkClick) SetMessage(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Message) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"message_link_clicks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"message_id"}),
+		strmangle.WhereClause("\"", "\"", 0, messageLinkClickPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.MessageID = related.ID
+	if o.R == nil {
+		o.R = &messageLinkClickR{
+			Message: related,
+		}
+	} else {
+		o.R.Message = related
+	}
+
+	if related.R == nil {
+		related.R = &messageR{
+			MessageLinkClicks: MessageLinkClickSlice{o},
+		}
+	} else {
+		related.R.MessageLinkClicks = append(related.R.MessageLinkClicks, o)
+	}
+
+	return nil
+}
+
+// MessageLinkClicks retrieves all the records using an executor.
+func MessageLinkClicks(mods ...qm.QueryMod) messageLinkClickQuery {
+	mods = append(mods, qm.From("\"message_link_clicks\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"message_link_clicks\".*"})
+	}
+
+	return messageLinkClickQuery{q}
+}
+
+// FindMessageLinkClick retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMessageLinkClick(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*MessageLinkClick, error) {
+	messageLinkClickObj := &MessageLinkClick{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"message_link_clicks\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, messageLinkClickObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from message_link_clicks")
+	}
+
+	if err = messageLinkClickObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return messageLinkClickObj, err
+	}
+
+	return messageLinkClickObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MessageLinkClick) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no message_link_clicks provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(messageLinkClickColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	messageLinkClickInsertCacheMut.RLock()
+	cache, cached := messageLinkClickInsertCache[key]
+	messageLinkClickInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			messageLinkClickAllColumns,
+			messageLinkClickColumnsWithDefault,
+			messageLinkClickColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, messageLinkClickGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(messageLinkClickType, messageLinkClickMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(messageLinkClickType, messageLinkClickMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"message_link_clicks\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"message_link_clicks\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into message_link_clicks")
+	}
+
+	if !cached {
+		messageLinkClickInsertCacheMut.Lock()
+		messageLinkClickInsertCache[key] = cache
+		messageLinkClickInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MessageLinkClick.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MessageLinkClick) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	messageLinkClickUpdateCacheMut.RLock()
+	cache, cached := messageLinkClickUpdateCache[key]
+	messageLinkClickUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			messageLinkClickAllColumns,
+			messageLinkClickPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, messageLinkClickGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update message_link_clicks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"message_link_clicks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, messageLinkClickPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(messageLinkClickType, messageLinkClickMapping, append(wl, messageLinkClickPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update message_link_clicks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for message_link_clicks")
+	}
+
+	if !cached {
+		messageLinkClickUpdateCacheMut.Lock()
+		messageLinkClickUpdateCache[key] = cache
+		messageLinkClickUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q messageLinkClickQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for message_link_clicks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for message_link_clicks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MessageLinkClickSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), messageLinkClickPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"message_link_clicks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, messageLinkClickPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in messageLinkClick slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all messageLinkClick")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MessageLinkClick) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no message_link_clicks provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(messageLinkClickColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	messageLinkClickUpsertCacheMut.RLock()
+	cache, cached := messageLinkClickUpsertCache[key]
+	messageLinkClickUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			messageLinkClickAllColumns,
+			messageLinkClickColumnsWithDefault,
+			messageLinkClickColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			messageLinkClickAllColumns,
+			messageLinkClickPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert message_link_clicks, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(messageLinkClickAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(messageLinkClickPrimaryKeyColumns))
+			copy(conflict, messageLinkClickPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"message_link_clicks\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(messageLinkClickType, messageLinkClickMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(messageLinkClickType, messageLinkClickMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert message_link_clicks")
+	}
+
+	if !cached {
+		messageLinkClickUpsertCacheMut.Lock()
+		messageLinkClickUpsertCache[key] = cache
+		messageLinkClickUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MessageLinkClick record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MessageLinkClick) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MessageLinkClick provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), messageLinkClickPrimaryKeyMapping)
+	sql := "DELETE FROM \"message_link_clicks\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from message_link_clicks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for message_link_clicks")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q messageLinkClickQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no messageLinkClickQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from message_link_clicks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for message_link_clicks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MessageLinkClickSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(messageLinkClickBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), messageLinkClickPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"message_link_clicks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, messageLinkClickPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from messageLinkClick slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for message_link_clicks")
+	}
+
+	if len(messageLinkClickAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MessageLinkClick) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMessageLinkClick(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MessageLinkClickSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MessageLinkClickSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), messageLinkClickPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"message_link_clicks\".* FROM \"message_link_clicks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, messageLinkClickPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MessageLinkClickSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MessageLinkClickExists checks if the MessageLinkClick row exists.
+func MessageLinkClickExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"message_link_clicks\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if message_link_clicks exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MessageLinkClick row exists.
+func (o *MessageLinkClick) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MessageLinkClickExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1344 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MessageStat is an object representing the database table.
+type MessageStat struct {
+	ID          int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	MessageID   int64     `boil:"message_id" json:"message_id" toml:"message_id" yaml:"message_id"`
+	WebsiteID   int64     `boil:"website_id" json:"website_id" toml:"website_id" yaml:"website_id"`
+	Language    string    `boil:"language" json:"language" toml:"language" yaml:"language"`
+	Hour        time.Time `boil:"hour" json:"hour" toml:"hour" yaml:"hour"`
+	Impressions int64     `boil:"impressions" json:"impressions" toml:"impressions" yaml:"impressions"`
+	Clicks      int64     `boil:"clicks" json:"clicks" toml:"clicks" yaml:"clicks"`
+	Dismissals  int64     `boil:"dismissals" json:"dismissals" toml:"dismissals" yaml:"dismissals"`
+
+	R *messageStatR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L messageStatL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MessageStatColumns = struct {
+	ID          string
+	MessageID   string
+	WebsiteID   string
+	Language    string
+	Hour        string
+	Impressions string
+	Clicks      string
+	Dismissals  string
+}{
+	ID:          "id",
+	MessageID:   "message_id",
+	WebsiteID:   "website_id",
+	Language:    "language",
+	Hour:        "hour",
+	Impressions: "impressions",
+	Clicks:      "clicks",
+	Dismissals:  "dismissals",
+}
+
+var MessageStatTableColumns = struct {
+	ID          string
+	MessageID   string
+	WebsiteID   string
+	Language    string
+	Hour        string
+	Impressions string
+	Clicks      string
+	Dismissals  string
+}{
+	ID:          "message_stats.id",
+	MessageID:   "message_stats.message_id",
+	WebsiteID:   "message_stats.website_id",
+	Language:    "message_stats.language",
+	Hour:        "message_stats.hour",
+	Impressions: "message_stats.impressions",
+	Clicks:      "message_stats.clicks",
+	Dismissals:  "message_stats.dismissals",
+}
+
+// Generated where
+
+var MessageStatWhere = struct {
+	ID          whereHelperint64
+	MessageID   whereHelperint64
+	WebsiteID   whereHelperint64
+	Language    whereHelperstring
+	Hour        whereHelpertime_Time
+	Impressions whereHelperint64
+	Clicks      whereHelperint64
+	Dismissals  whereHelperint64
+}{
+	ID:          whereHelperint64{field: "\"message_stats\".\"id\""},
+	MessageID:   whereHelperint64{field: "\"message_stats\".\"message_id\""},
+	WebsiteID:   whereHelperint64{field: "\"message_stats\".\"website_id\""},
+	Language:    whereHelperstring{field: "\"message_stats\".\"language\""},
+	Hour:        whereHelpertime_Time{field: "\"message_stats\".\"hour\""},
+	Impressions: whereHelperint64{field: "\"message_stats\".\"impressions\""},
+	Clicks:      whereHelperint64{field: "\"message_stats\".\"clicks\""},
+	Dismissals:  whereHelperint64{field: "\"message_stats\".\"dismissals\""},
+}
+
+// MessageStatRels is where relationship names are stored.
+var MessageStatRels = struct {
+	Website string
+	Message string
+}{
+	Website: "Website",
+	Message: "Message",
+}
+
+// messageStatR is where relationships are stored.
+type messageStatR struct {
+	Website *Website `boil:"Website" json:"Website" toml:"Website" yaml:"Website"`
+	Message *Message `boil:"Message" json:"Message" toml:"Message" yaml:"Message"`
+}
+
+// NewStruct creates a new relationship struct
+func (*messageStatR) NewStruct() *messageStatR {
+	return &messageStatR{}
+}
+
+func (r *messageStatR) GetWebsite() *Website {
+	if r == nil {
+		return nil
+	}
+	return r.Website
+}
+
+func (r *messageStatR) GetMessage() *Message {
+	if r == nil {
+		return nil
+	}
+	return r.Message
+}
+
+// messageStatL is where Load methods for each relationship are stored.
+type messageStatL struct{}
+
+var (
+	messageStatAllColumns            = []string{"id", "message_id", "website_id", "language", "hour", "impressions", "clicks", "dismissals"}
+	messageStatColumnsWithoutDefault = []string{"message_id", "website_id", "language", "hour"}
+	messageStatColumnsWithDefault    = []string{"id", "impressions", "clicks", "dismissals"}
+	messageStatPrimaryKeyColumns     = []string{"id"}
+	messageStatGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// MessageStatSlice is an alias for a slice of pointers to MessageStat.
+	// This should almost always be used instead of []MessageStat.
+	MessageStatSlice []*MessageStat
+	// MessageStatHook is the signature for custom MessageStat hook methods
+	MessageStatHook func(context.Context, boil.ContextExecutor, *MessageStat) error
+
+	messageStatQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	messageStatType                 = reflect.TypeOf(&MessageStat{})
+	messageStatMapping              = queries.MakeStructMapping(messageStatType)
+	messageStatPrimaryKeyMapping, _ = queries.BindMapping(messageStatType, messageStatMapping, messageStatPrimaryKeyColumns)
+	messageStatInsertCacheMut       sync.RWMutex
+	messageStatInsertCache          = make(map[string]insertCache)
+	messageStatUpdateCacheMut       sync.RWMutex
+	messageStatUpdateCache          = make(map[string]updateCache)
+	messageStatUpsertCacheMut       sync.RWMutex
+	messageStatUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var messageStatAfterSelectMu sync.Mutex
+var messageStatAfterSelectHooks []MessageStatHook
+
+var messageStatBeforeInsertMu sync.Mutex
+var messageStatBeforeInsertHooks []MessageStatHook
+var messageStatAfterInsertMu sync.Mutex
+var messageStatAfterInsertHooks []MessageStatHook
+
+var messageStatBeforeUpdateMu sync.Mutex
+var messageStatBeforeUpdateHooks []MessageStatHook
+var messageStatAfterUpdateMu sync.Mutex
+var messageStatAfterUpdateHooks []MessageStatHook
+
+var messageStatBeforeDeleteMu sync.Mutex
+var messageStatBeforeDeleteHooks []MessageStatHook
+var messageStatAfterDeleteMu sync.Mutex
+var messageStatAfterDeleteHooks []MessageStatHook
+
+var messageStatBeforeUpsertMu sync.Mutex
+var messageStatBeforeUpsertHooks []MessageStatHook
+var messageStatAfterUpsertMu sync.Mutex
+var messageStatAfterUpsertHooks []MessageStatHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MessageStat) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageStatAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MessageStat) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageStatBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MessageStat) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageStatAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MessageStat) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageStatBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MessageStat) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageStatAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MessageStat) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageStatBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MessageStat) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageStatAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MessageStat) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageStatBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MessageStat) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageStatAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMessageStatHook registers your hook function for all future operations.
+func AddMessageStatHook(hookPoint boil.HookPoint, messageStatHook MessageStatHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		messageStatAfterSelectMu.Lock()
+		messageStatAfterSelectHooks = append(messageStatAfterSelectHooks, messageStatHook)
+		messageStatAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		messageStatBeforeInsertMu.Lock()
+		messageStatBeforeInsertHooks = append(messageStatBeforeInsertHooks, messageStatHook)
+		messageStatBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		messageStatAfterInsertMu.Lock()
+		messageStatAfterInsertHooks = append(messageStatAfterInsertHooks, messageStatHook)
+		messageStatAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		messageStatBeforeUpdateMu.Lock()
+		messageStatBeforeUpdateHooks = append(messageStatBeforeUpdateHooks, messageStatHook)
+		messageStatBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		messageStatAfterUpdateMu.Lock()
+		messageStatAfterUpdateHooks = append(messageStatAfterUpdateHooks, messageStatHook)
+		messageStatAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		messageStatBeforeDeleteMu.Lock()
+		messageStatBeforeDeleteHooks = append(messageStatBeforeDeleteHooks, messageStatHook)
+		messageStatBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		messageStatAfterDeleteMu.Lock()
+		messageStatAfterDeleteHooks = append(messageStatAfterDeleteHooks, messageStatHook)
+		messageStatAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		messageStatBeforeUpsertMu.Lock()
+		messageStatBeforeUpsertHooks = append(messageStatBeforeUpsertHooks, messageStatHook)
+		messageStatBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		messageStatAfterUpsertMu.Lock()
+		messageStatAfterUpsertHooks = append(messageStatAfterUpsertHooks, messageStatHook)
+		messageStatAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single messageStat record from the query.
+func (q messageStatQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MessageStat, error) {
+	o := &MessageStat{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for message_stats")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MessageStat records from the query.
+func (q messageStatQuery) All(ctx context.Context, exec boil.ContextExecutor) (MessageStatSlice, error) {
+	var o []*MessageStat
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MessageStat slice")
+	}
+
+	if len(messageStatAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MessageStat records in the query.
+func (q messageStatQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count message_stats rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q messageStatQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if message_stats exists")
+	}
+
+	return count > 0, nil
+}
+
+// Website pointed to by the foreign key.
+func (o *MessageStat) Website(mods ...qm.QueryMod) websiteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WebsiteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Websites(queryMods...)
+}
+
+// Message pointed to by the foreign key.
+func (o *MessageStat) Message(mods ...qm.QueryMod) messageQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.MessageID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Messages(queryMods...)
+}
+
+// LoadWebsite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (messageStatL) LoadWebsite(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMessageStat interface{}, mods queries.Applicator) error {
+	var slice []*MessageStat
+	var object *MessageStat
+
+	if singular {
+		var ok bool
+		object, ok = maybeMessageStat.(*MessageStat)
+		if !ok {
+			object = new(MessageStat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMessageStat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMessageStat))
+			}
+		}
+	} else {
+		s, ok := maybeMessageStat.(*[]*MessageStat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMessageStat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMessageStat))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &messageStatR{}
+		}
+		args[object.WebsiteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &messageStatR{}
+			}
+
+			args[obj.WebsiteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites`),
+		qm.WhereIn(`websites.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Website")
+	}
+
+	var resultSlice []*Website
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Website")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for websites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
+	}
+
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Website = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteR{}
+		}
+		foreign.R.MessageStats = append(foreign.R.MessageStats, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebsiteID == foreign.ID {
+				local.R.Website = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.MessageStats = append(foreign.R.MessageStats, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMessage allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (messageStatL) LoadMessage(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMessageStat interface{}, mods queries.Applicator) error {
+	var slice []*MessageStat
+	var object *MessageStat
+
+	if singular {
+		var ok bool
+		object, ok = maybeMessageStat.(*MessageStat)
+		if !ok {
+			object = new(MessageStat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMessageStat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMessageStat))
+			}
+		}
+	} else {
+		s, ok := maybeMessageStat.(*[]*MessageStat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMessageStat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMessageStat))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &messageStatR{}
+		}
+		args[object.MessageID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &messageStatR{}
+			}
+
+			args[obj.MessageID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`messages`),
+		qm.WhereIn(`messages.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Message")
+	}
+
+	var resultSlice []*Message
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Message")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for messages")
+	}
+
+	if len(messageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Message = foreign
+		if foreign.R == nil {
+			foreign.R = &messageR{}
+		}
+		foreign.R.MessageStats = append(foreign.R.MessageStats, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.MessageID == foreign.ID {
+				local.R.Message = foreign
+				if foreign.R == nil {
+					foreign.R = &messageR{}
+				}
+				foreign.R.MessageStats = append(foreign.R.MessageStats, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebsite of the messageStat to the related item.
+// Sets o.R.Website to related.
+// Adds o to related.R.MessageStats.
+func (o *MessageStat) SetWebsite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Website) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"message_stats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"website_id"}),
+		strmangle.WhereClause("\"", "\"", 0, messageStatPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebsiteID = related.ID
+	if o.R == nil {
+		o.R = &messageStatR{
+			Website: related,
+		}
+	} else {
+		o.R.Website = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteR{
+			MessageStats: MessageStatSlice{o},
+		}
+	} else {
+		related.R.MessageStats = append(related.R.MessageStats, o)
+	}
+
+	return nil
+}
+
+// SetMessage of the messageStat to the related item.
+// Sets o.R.Message to related.
+// Adds o to related.R.MessageStats.
+func (o *MessageStat) SetMessage(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Message) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"message_stats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"message_id"}),
+		strmangle.WhereClause("\"", "\"", 0, messageStatPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.MessageID = related.ID
+	if o.R == nil {
+		o.R = &messageStatR{
+			Message: related,
+		}
+	} else {
+		o.R.Message = related
+	}
+
+	if related.R == nil {
+		related.R = &messageR{
+			MessageStats: MessageStatSlice{o},
+		}
+	} else {
+		related.R.MessageStats = append(related.R.MessageStats, o)
+	}
+
+	return nil
+}
+
+// MessageStats retrieves all the records using an executor.
+func MessageStats(mods ...qm.QueryMod) messageStatQuery {
+	mods = append(mods, qm.From("\"message_stats\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"message_stats\".*"})
+	}
+
+	return messageStatQuery{q}
+}
+
+// FindMessageStat retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMessageStat(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*MessageStat, error) {
+	messageStatObj := &MessageStat{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"message_stats\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, messageStatObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from message_stats")
+	}
+
+	if err = messageStatObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return messageStatObj, err
+	}
+
+	return messageStatObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MessageStat) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no message_stats provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(messageStatColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	messageStatInsertCacheMut.RLock()
+	cache, cached := messageStatInsertCache[key]
+	messageStatInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			messageStatAllColumns,
+			messageStatColumnsWithDefault,
+			messageStatColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, messageStatGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(messageStatType, messageStatMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(messageStatType, messageStatMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"message_stats\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"message_stats\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into message_stats")
+	}
+
+	if !cached {
+		messageStatInsertCacheMut.Lock()
+		messageStatInsertCache[key] = cache
+		messageStatInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MessageStat.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MessageStat) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	messageStatUpdateCacheMut.RLock()
+	cache, cached := messageStatUpdateCache[key]
+	messageStatUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			messageStatAllColumns,
+			messageStatPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, messageStatGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update message_stats, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"message_stats\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, messageStatPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(messageStatType, messageStatMapping, append(wl, messageStatPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update message_stats row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for message_stats")
+	}
+
+	if !cached {
+		messageStatUpdateCacheMut.Lock()
+		messageStatUpdateCache[key] = cache
+		messageStatUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q messageStatQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for message_stats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for message_stats")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MessageStatSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), messageStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"message_stats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, messageStatPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in messageStat slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all messageStat")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MessageStat) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no message_stats provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(messageStatColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	messageStatUpsertCacheMut.RLock()
+	cache, cached := messageStatUpsertCache[key]
+	messageStatUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			messageStatAllColumns,
+			messageStatColumnsWithDefault,
+			messageStatColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			messageStatAllColumns,
+			messageStatPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert message_stats, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(messageStatAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(messageStatPrimaryKeyColumns))
+			copy(conflict, messageStatPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"message_stats\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(messageStatType, messageStatMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(messageStatType, messageStatMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert message_stats")
+	}
+
+	if !cached {
+		messageStatUpsertCacheMut.Lock()
+		messageStatUpsertCache[key] = cache
+		messageStatUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MessageStat record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MessageStat) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MessageStat provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), messageStatPrimaryKeyMapping)
+	sql := "DELETE FROM \"message_stats\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from message_stats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for message_stats")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q messageStatQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no messageStatQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from message_stats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for message_stats")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MessageStatSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(messageStatBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), messageStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"message_stats\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, messageStatPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from messageStat slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for message_stats")
+	}
+
+	if len(messageStatAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MessageStat) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMessageStat(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MessageStatSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MessageStatSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), messageStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"message_stats\".* FROM \"message_stats\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, messageStatPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MessageStatSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MessageStatExists checks if the MessageStat row exists.
+func MessageStatExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"message_stats\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if message_stats exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MessageStat row exists.
+func (o *MessageStat) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MessageStatExists(ctx, exec, o.ID)
+}
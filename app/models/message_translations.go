@@ -0,0 +1,1173 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MessageTranslation is an object representing the database table.
+type MessageTranslation struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	MessageID int64     `boil:"message_id" json:"message_id" toml:"message_id" yaml:"message_id"`
+	Language  string    `boil:"language" json:"language" toml:"language" yaml:"language"`
+	Title     string    `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Content   string    `boil:"content" json:"content" toml:"content" yaml:"content"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *messageTranslationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L messageTranslationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MessageTranslationColumns = struct {
+	ID        string
+	MessageID string
+	Language  string
+	Title     string
+	Content   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	MessageID: "message_id",
+	Language:  "language",
+	Title:     "title",
+	Content:   "content",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var MessageTranslationTableColumns = struct {
+	ID        string
+	MessageID string
+	Language  string
+	Title     string
+	Content   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "message_translations.id",
+	MessageID: "message_translations.message_id",
+	Language:  "message_translations.language",
+	Title:     "message_translations.title",
+	Content:   "message_translations.content",
+	CreatedAt: "message_translations.created_at",
+	UpdatedAt: "message_translations.updated_at",
+}
+
+// Generated where
+
+var MessageTranslationWhere = struct {
+	ID        whereHelperint64
+	MessageID whereHelperint64
+	Language  whereHelperstring
+	Title     whereHelperstring
+	Content   whereHelperstring
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint64{field: "\"message_translations\".\"id\""},
+	MessageID: whereHelperint64{field: "\"message_translations\".\"message_id\""},
+	Language:  whereHelperstring{field: "\"message_translations\".\"language\""},
+	Title:     whereHelperstring{field: "\"message_translations\".\"title\""},
+	Content:   whereHelperstring{field: "\"message_translations\".\"content\""},
+	CreatedAt: whereHelpertime_Time{field: "\"message_translations\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"message_translations\".\"updated_at\""},
+}
+
+// MessageTranslationRels is where relationship names are stored.
+var MessageTranslationRels = struct {
+	Message string
+}{
+	Message: "Message",
+}
+
+// messageTranslationR is where relationships are stored.
+type messageTranslationR struct {
+	Message *Message `boil:"Message" json:"Message" toml:"Message" yaml:"Message"`
+}
+
+// NewStruct creates a new relationship struct
+func (*messageTranslationR) NewStruct() *messageTranslationR {
+	return &messageTranslationR{}
+}
+
+func (r *messageTranslationR) GetMessage() *Message {
+	if r == nil {
+		return nil
+	}
+	return r.Message
+}
+
+// messageTranslationL is where Load methods for each relationship are stored.
+type messageTranslationL struct{}
+
+var (
+	messageTranslationAllColumns            = []string{"id", "message_id", "language", "title", "content", "created_at", "updated_at"}
+	messageTranslationColumnsWithoutDefault = []string{"message_id", "language", "title", "content", "created_at", "updated_at"}
+	messageTranslationColumnsWithDefault    = []string{"id"}
+	messageTranslationPrimaryKeyColumns     = []string{"id"}
+	messageTranslationGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// MessageTranslationSlice is an alias for a slice of pointers to MessageTranslation.
+	// This should almost always be used instead of []MessageTranslation.
+	MessageTranslationSlice []*MessageTranslation
+	// MessageTranslationHook is the signature for custom MessageTranslation hook methods
+	MessageTranslationHook func(context.Context, boil.ContextExecutor, *MessageTranslation) error
+
+	messageTranslationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	messageTranslationType                 = reflect.TypeOf(&MessageTranslation{})
+	messageTranslationMapping              = queries.MakeStructMapping(messageTranslationType)
+	messageTranslationPrimaryKeyMapping, _ = queries.BindMapping(messageTranslationType, messageTranslationMapping, messageTranslationPrimaryKeyColumns)
+	messageTranslationInsertCacheMut       sync.RWMutex
+	messageTranslationInsertCache          = make(map[string]insertCache)
+	messageTranslationUpdateCacheMut       sync.RWMutex
+	messageTranslationUpdateCache          = make(map[string]updateCache)
+	messageTranslationUpsertCacheMut       sync.RWMutex
+	messageTranslationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var messageTranslationAfterSelectMu sync.Mutex
+var messageTranslationAfterSelectHooks []MessageTranslationHook
+
+var messageTranslationBeforeInsertMu sync.Mutex
+var messageTranslationBeforeInsertHooks []MessageTranslationHook
+var messageTranslationAfterInsertMu sync.Mutex
+var messageTranslationAfterInsertHooks []MessageTranslationHook
+
+var messageTranslationBeforeUpdateMu sync.Mutex
+var messageTranslationBeforeUpdateHooks []MessageTranslationHook
+var messageTranslationAfterUpdateMu sync.Mutex
+var messageTranslationAfterUpdateHooks []MessageTranslationHook
+
+var messageTranslationBeforeDeleteMu sync.Mutex
+var messageTranslationBeforeDeleteHooks []MessageTranslationHook
+var messageTranslationAfterDeleteMu sync.Mutex
+var messageTranslationAfterDeleteHooks []MessageTranslationHook
+
+var messageTranslationBeforeUpsertMu sync.Mutex
+var messageTranslationBeforeUpsertHooks []MessageTranslationHook
+var messageTranslationAfterUpsertMu sync.Mutex
+var messageTranslationAfterUpsertHooks []MessageTranslationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MessageTranslation) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageTranslationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MessageTranslation) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageTranslationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MessageTranslation) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageTranslationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MessageTranslation) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageTranslationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MessageTranslation) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageTranslationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MessageTranslation) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageTranslationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MessageTranslation) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageTranslationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MessageTranslation) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageTranslationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MessageTranslation) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range messageTranslationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMessageTranslationHook registers your hook function for all future operations.
+func AddMessageTranslationHook(hookPoint boil.HookPoint, messageTranslationHook MessageTranslationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		messageTranslationAfterSelectMu.Lock()
+		messageTranslationAfterSelectHooks = append(messageTranslationAfterSelectHooks, messageTranslationHook)
+		messageTranslationAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		messageTranslationBeforeInsertMu.Lock()
+		messageTranslationBeforeInsertHooks = append(messageTranslationBeforeInsertHooks, messageTranslationHook)
+		messageTranslationBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		messageTranslationAfterInsertMu.Lock()
+		messageTranslationAfterInsertHooks = append(messageTranslationAfterInsertHooks, messageTranslationHook)
+		messageTranslationAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		messageTranslationBeforeUpdateMu.Lock()
+		messageTranslationBeforeUpdateHooks = append(messageTranslationBeforeUpdateHooks, messageTranslationHook)
+		messageTranslationBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		messageTranslationAfterUpdateMu.Lock()
+		messageTranslationAfterUpdateHooks = append(messageTranslationAfterUpdateHooks, messageTranslationHook)
+		messageTranslationAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		messageTranslationBeforeDeleteMu.Lock()
+		messageTranslationBeforeDeleteHooks = append(messageTranslationBeforeDeleteHooks, messageTranslationHook)
+		messageTranslationBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		messageTranslationAfterDeleteMu.Lock()
+		messageTranslationAfterDeleteHooks = append(messageTranslationAfterDeleteHooks, messageTranslationHook)
+		messageTranslationAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		messageTranslationBeforeUpsertMu.Lock()
+		messageTranslationBeforeUpsertHooks = append(messageTranslationBeforeUpsertHooks, messageTranslationHook)
+		messageTranslationBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		messageTranslationAfterUpsertMu.Lock()
+		messageTranslationAfterUpsertHooks = append(messageTranslationAfterUpsertHooks, messageTranslationHook)
+		messageTranslationAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single messageTranslation record from the query.
+func (q messageTranslationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MessageTranslation, error) {
+	o := &MessageTranslation{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for message_translations")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MessageTranslation records from the query.
+func (q messageTranslationQuery) All(ctx context.Context, exec boil.ContextExecutor) (MessageTranslationSlice, error) {
+	var o []*MessageTranslation
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MessageTranslation slice")
+	}
+
+	if len(messageTranslationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MessageTranslation records in the query.
+func (q messageTranslationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count message_translations rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q messageTranslationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if message_translations exists")
+	}
+
+	return count > 0, nil
+}
+
+// Message pointed to by the foreign key.
+func (o *MessageTranslation) Message(mods ...qm.QueryMod) messageQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.MessageID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Messages(queryMods...)
+}
+
+// LoadMessage allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (messageTranslationL) LoadMessage(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMessageTranslation interface{}, mods queries.Applicator) error {
+	var slice []*MessageTranslation
+	var object *MessageTranslation
+
+	if singular {
+		var ok bool
+		object, ok = maybeMessageTranslation.(*MessageTranslation)
+		if !ok {
+			object = new(MessageTranslation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMessageTranslation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMessageTranslation))
+			}
+		}
+	} else {
+		s, ok := maybeMessageTranslation.(*[]*MessageTranslation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMessageTranslation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMessageTranslation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &messageTranslationR{}
+		}
+		args[object.MessageID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &messageTranslationR{}
+			}
+
+			args[obj.MessageID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`messages`),
+		qm.WhereIn(`messages.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Message")
+	}
+
+	var resultSlice []*Message
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Message")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for messages")
+	}
+
+	if len(messageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Message = foreign
+		if foreign.R == nil {
+			foreign.R = &messageR{}
+		}
+		foreign.R.MessageTranslations = append(foreign.R.MessageTranslations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.MessageID == foreign.ID {
+				local.R.Message = foreign
+				if foreign.R == nil {
+					foreign.R = &messageR{}
+				}
+				foreign.R.MessageTranslations = append(foreign.R.MessageTranslations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetMessage of the messageTranslation to the related item.
+// Sets o.R.Message to related.
+// Adds o to related.R.MessageTranslations.
+func (o *MessageTranslation) SetMessage(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Message) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"message_translations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"message_id"}),
+		strmangle.WhereClause("\"", "\"", 0, messageTranslationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.MessageID = related.ID
+	if o.R == nil {
+		o.R = &messageTranslationR{
+			Message: related,
+		}
+	} else {
+		o.R.Message = related
+	}
+
+	if related.R == nil {
+		related.R = &messageR{
+			MessageTranslations: MessageTranslationSlice{o},
+		}
+	} else {
+		related.R.MessageTranslations = append(related.R.MessageTranslations, o)
+	}
+
+	return nil
+}
+
+// MessageTranslations retrieves all the records using an executor.
+func MessageTranslations(mods ...qm.QueryMod) messageTranslationQuery {
+	mods = append(mods, qm.From("\"message_translations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"message_translations\".*"})
+	}
+
+	return messageTranslationQuery{q}
+}
+
+// FindMessageTranslation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMessageTranslation(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*MessageTranslation, error) {
+	messageTranslationObj := &MessageTranslation{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"message_translations\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, messageTranslationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from message_translations")
+	}
+
+	if err = messageTranslationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return messageTranslationObj, err
+	}
+
+	return messageTranslationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MessageTranslation) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no message_translations provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(messageTranslationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	messageTranslationInsertCacheMut.RLock()
+	cache, cached := messageTranslationInsertCache[key]
+	messageTranslationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			messageTranslationAllColumns,
+			messageTranslationColumnsWithDefault,
+			messageTranslationColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, messageTranslationGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(messageTranslationType, messageTranslationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(messageTranslationType, messageTranslationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"message_translations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"message_translations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into message_translations")
+	}
+
+	if !cached {
+		messageTranslationInsertCacheMut.Lock()
+		messageTranslationInsertCache[key] = cache
+		messageTranslationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MessageTranslation.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MessageTranslation) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	messageTranslationUpdateCacheMut.RLock()
+	cache, cached := messageTranslationUpdateCache[key]
+	messageTranslationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			messageTranslationAllColumns,
+			messageTranslationPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, messageTranslationGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update message_translations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"message_translations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, messageTranslationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(messageTranslationType, messageTranslationMapping, append(wl, messageTranslationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update message_translations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for message_translations")
+	}
+
+	if !cached {
+		messageTranslationUpdateCacheMut.Lock()
+		messageTranslationUpdateCache[key] = cache
+		messageTranslationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q messageTranslationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for message_translations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for message_translations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MessageTranslationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), messageTranslationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"message_translations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, messageTranslationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in messageTranslation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all messageTranslation")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MessageTranslation) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no message_translations provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(messageTranslationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	messageTranslationUpsertCacheMut.RLock()
+	cache, cached := messageTranslationUpsertCache[key]
+	messageTranslationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			messageTranslationAllColumns,
+			messageTranslationColumnsWithDefault,
+			messageTranslationColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			messageTranslationAllColumns,
+			messageTranslationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert message_translations, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(messageTranslationAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(messageTranslationPrimaryKeyColumns))
+			copy(conflict, messageTranslationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"message_translations\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(messageTranslationType, messageTranslationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(messageTranslationType, messageTranslationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert message_translations")
+	}
+
+	if !cached {
+		messageTranslationUpsertCacheMut.Lock()
+		messageTranslationUpsertCache[key] = cache
+		messageTranslationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MessageTranslation record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MessageTranslation) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MessageTranslation provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), messageTranslationPrimaryKeyMapping)
+	sql := "DELETE FROM \"message_translations\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from message_translations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for message_translations")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q messageTranslationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no messageTranslationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from message_translations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for message_translations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MessageTranslationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(messageTranslationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), messageTranslationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"message_translations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, messageTranslationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from messageTranslation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for message_translations")
+	}
+
+	if len(messageTranslationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MessageTranslation) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMessageTranslation(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MessageTranslationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MessageTranslationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), messageTranslationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"message_translations\".* FROM \"message_translations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, messageTranslationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MessageTranslationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MessageTranslationExists checks if the MessageTranslation row exists.
+func MessageTranslationExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"message_translations\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if message_translations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MessageTranslation row exists.
+func (o *MessageTranslation) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MessageTranslationExists(ctx, exec, o.ID)
+}
@@ -23,108 +23,155 @@ import (
 
 // Message is an object representing the database table.
 type Message struct {
-	ID          int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Title       string    `boil:"title" json:"title" toml:"title" yaml:"title"`
-	Message     string    `boil:"message" json:"message" toml:"message" yaml:"message"`
-	Language    string    `boil:"language" json:"language" toml:"language" yaml:"language"`
-	UserId      int64     `boil:"userId" json:"userId" toml:"userId" yaml:"userId"`
-	DisplayFrom time.Time `boil:"display_from" json:"display_from" toml:"display_from" yaml:"display_from"`
-	DisplayTo   time.Time `boil:"display_to" json:"display_to" toml:"display_to" yaml:"display_to"`
-	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	Type        string    `boil:"type" json:"type" toml:"type" yaml:"type"`
+	ID                   int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserId               int64     `boil:"userId" json:"userId" toml:"userId" yaml:"userId"`
+	DisplayFrom          time.Time `boil:"display_from" json:"display_from" toml:"display_from" yaml:"display_from"`
+	DisplayTo            time.Time `boil:"display_to" json:"display_to" toml:"display_to" yaml:"display_to"`
+	CreatedAt            time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt            time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Type                 string    `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Revision             int64     `boil:"revision" json:"revision" toml:"revision" yaml:"revision"`
+	Timezone             string    `boil:"timezone" json:"timezone" toml:"timezone" yaml:"timezone"`
+	RecurrenceRule       string    `boil:"recurrence_rule" json:"recurrence_rule" toml:"recurrence_rule" yaml:"recurrence_rule"`
+	RecurrenceExceptions string    `boil:"recurrence_exceptions" json:"recurrence_exceptions" toml:"recurrence_exceptions" yaml:"recurrence_exceptions"`
+	RecurrenceDuration   int64     `boil:"recurrence_duration" json:"recurrence_duration" toml:"recurrence_duration" yaml:"recurrence_duration"`
+	DailyFrom            string    `boil:"daily_from" json:"daily_from" toml:"daily_from" yaml:"daily_from"`
+	DailyTo              string    `boil:"daily_to" json:"daily_to" toml:"daily_to" yaml:"daily_to"`
+	Weekdays             string    `boil:"weekdays" json:"weekdays" toml:"weekdays" yaml:"weekdays"`
 
 	R *messageR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L messageL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var MessageColumns = struct {
-	ID          string
-	Title       string
-	Message     string
-	Language    string
-	UserId      string
-	DisplayFrom string
-	DisplayTo   string
-	CreatedAt   string
-	UpdatedAt   string
-	Type        string
+	ID                   string
+	UserId               string
+	DisplayFrom          string
+	DisplayTo            string
+	CreatedAt            string
+	UpdatedAt            string
+	Type                 string
+	Revision             string
+	Timezone             string
+	RecurrenceRule       string
+	RecurrenceExceptions string
+	RecurrenceDuration   string
+	DailyFrom            string
+	DailyTo              string
+	Weekdays             string
 }{
-	ID:          "id",
-	Title:       "title",
-	Message:     "message",
-	Language:    "language",
-	UserId:      "userId",
-	DisplayFrom: "display_from",
-	DisplayTo:   "display_to",
-	CreatedAt:   "created_at",
-	UpdatedAt:   "updated_at",
-	Type:        "type",
+	ID:                   "id",
+	UserId:               "userId",
+	DisplayFrom:          "display_from",
+	DisplayTo:            "display_to",
+	CreatedAt:            "created_at",
+	UpdatedAt:            "updated_at",
+	Type:                 "type",
+	Revision:             "revision",
+	Timezone:             "timezone",
+	RecurrenceRule:       "recurrence_rule",
+	RecurrenceExceptions: "recurrence_exceptions",
+	RecurrenceDuration:   "recurrence_duration",
+	DailyFrom:            "daily_from",
+	DailyTo:              "daily_to",
+	Weekdays:             "weekdays",
 }
 
 var MessageTableColumns = struct {
-	ID          string
-	Title       string
-	Message     string
-	Language    string
-	UserId      string
-	DisplayFrom string
-	DisplayTo   string
-	CreatedAt   string
-	UpdatedAt   string
-	Type        string
+	ID                   string
+	UserId               string
+	DisplayFrom          string
+	DisplayTo            string
+	CreatedAt            string
+	UpdatedAt            string
+	Type                 string
+	Revision             string
+	Timezone             string
+	RecurrenceRule       string
+	RecurrenceExceptions string
+	RecurrenceDuration   string
+	DailyFrom            string
+	DailyTo              string
+	Weekdays             string
 }{
-	ID:          "messages.id",
-	Title:       "messages.title",
-	Message:     "messages.message",
-	Language:    "messages.language",
-	UserId:      "messages.userId",
-	DisplayFrom: "messages.display_from",
-	DisplayTo:   "messages.display_to",
-	CreatedAt:   "messages.created_at",
-	UpdatedAt:   "messages.updated_at",
-	Type:        "messages.type",
+	ID:                   "messages.id",
+	UserId:               "messages.userId",
+	DisplayFrom:          "messages.display_from",
+	DisplayTo:            "messages.display_to",
+	CreatedAt:            "messages.created_at",
+	UpdatedAt:            "messages.updated_at",
+	Type:                 "messages.type",
+	Revision:             "messages.revision",
+	Timezone:             "messages.timezone",
+	RecurrenceRule:       "messages.recurrence_rule",
+	RecurrenceExceptions: "messages.recurrence_exceptions",
+	RecurrenceDuration:   "messages.recurrence_duration",
+	DailyFrom:            "messages.daily_from",
+	DailyTo:              "messages.daily_to",
+	Weekdays:             "messages.weekdays",
 }
 
 // Generated where
 
 var MessageWhere = struct {
-	ID          whereHelperint64
-	Title       whereHelperstring
-	Message     whereHelperstring
-	Language    whereHelperstring
-	UserId      whereHelperint64
-	DisplayFrom whereHelpertime_Time
-	DisplayTo   whereHelpertime_Time
-	CreatedAt   whereHelpertime_Time
-	UpdatedAt   whereHelpertime_Time
-	Type        whereHelperstring
+	ID                   whereHelperint64
+	UserId               whereHelperint64
+	DisplayFrom          whereHelpertime_Time
+	DisplayTo            whereHelpertime_Time
+	CreatedAt            whereHelpertime_Time
+	UpdatedAt            whereHelpertime_Time
+	Type                 whereHelperstring
+	Revision             whereHelperint64
+	Timezone             whereHelperstring
+	RecurrenceRule       whereHelperstring
+	RecurrenceExceptions whereHelperstring
+	RecurrenceDuration   whereHelperint64
+	DailyFrom            whereHelperstring
+	DailyTo              whereHelperstring
+	Weekdays             whereHelperstring
 }{
-	ID:          whereHelperint64{field: "\"messages\".\"id\""},
-	Title:       whereHelperstring{field: "\"messages\".\"title\""},
-	Message:     whereHelperstring{field: "\"messages\".\"message\""},
-	Language:    whereHelperstring{field: "\"messages\".\"language\""},
-	UserId:      whereHelperint64{field: "\"messages\".\"userId\""},
-	DisplayFrom: whereHelpertime_Time{field: "\"messages\".\"display_from\""},
-	DisplayTo:   whereHelpertime_Time{field: "\"messages\".\"display_to\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"messages\".\"created_at\""},
-	UpdatedAt:   whereHelpertime_Time{field: "\"messages\".\"updated_at\""},
-	Type:        whereHelperstring{field: "\"messages\".\"type\""},
+	ID:                   whereHelperint64{field: "\"messages\".\"id\""},
+	UserId:               whereHelperint64{field: "\"messages\".\"userId\""},
+	DisplayFrom:          whereHelpertime_Time{field: "\"messages\".\"display_from\""},
+	DisplayTo:            whereHelpertime_Time{field: "\"messages\".\"display_to\""},
+	CreatedAt:            whereHelpertime_Time{field: "\"messages\".\"created_at\""},
+	UpdatedAt:            whereHelpertime_Time{field: "\"messages\".\"updated_at\""},
+	Type:                 whereHelperstring{field: "\"messages\".\"type\""},
+	Revision:             whereHelperint64{field: "\"messages\".\"revision\""},
+	Timezone:             whereHelperstring{field: "\"messages\".\"timezone\""},
+	RecurrenceRule:       whereHelperstring{field: "\"messages\".\"recurrence_rule\""},
+	RecurrenceExceptions: whereHelperstring{field: "\"messages\".\"recurrence_exceptions\""},
+	RecurrenceDuration:   whereHelperint64{field: "\"messages\".\"recurrence_duration\""},
+	DailyFrom:            whereHelperstring{field: "\"messages\".\"daily_from\""},
+	DailyTo:              whereHelperstring{field: "\"messages\".\"daily_to\""},
+	Weekdays:             whereHelperstring{field: "\"messages\".\"weekdays\""},
 }
 
 // MessageRels is where relationship names are stored.
 var MessageRels = struct {
 	UserIdUser                string
+	MessageLinkClicks         string
+	MessageStats              string
+	MessageTranslations       string
+	PreviewTokens             string
 	MessageIdWebsitesMessages string
 }{
 	UserIdUser:                "UserIdUser",
+	MessageLinkClicks:         "MessageLinkClicks",
+	MessageStats:              "MessageStats",
+	MessageTranslations:       "MessageTranslations",
+	PreviewTokens:             "PreviewTokens",
 	MessageIdWebsitesMessages: "MessageIdWebsitesMessages",
 }
 
 // messageR is where relationships are stored.
 type messageR struct {
-	UserIdUser                *User                `boil:"UserIdUser" json:"UserIdUser" toml:"UserIdUser" yaml:"UserIdUser"`
-	MessageIdWebsitesMessages WebsitesMessageSlice `boil:"MessageIdWebsitesMessages" json:"MessageIdWebsitesMessages" toml:"MessageIdWebsitesMessages" yaml:"MessageIdWebsitesMessages"`
+	UserIdUser                *User                   `boil:"UserIdUser" json:"UserIdUser" toml:"UserIdUser" yaml:"UserIdUser"`
+	MessageLinkClicks         MessageLinkClickSlice   `boil:"MessageLinkClicks" json:"MessageLinkClicks" toml:"MessageLinkClicks" yaml:"MessageLinkClicks"`
+	MessageStats              MessageStatSlice        `boil:"MessageStats" json:"MessageStats" toml:"MessageStats" yaml:"MessageStats"`
+	MessageTranslations       MessageTranslationSlice `boil:"MessageTranslations" json:"MessageTranslations" toml:"MessageTranslations" yaml:"MessageTranslations"`
+	PreviewTokens             PreviewTokenSlice       `boil:"PreviewTokens" json:"PreviewTokens" toml:"PreviewTokens" yaml:"PreviewTokens"`
+	MessageIdWebsitesMessages WebsitesMessageSlice    `boil:"MessageIdWebsitesMessages" json:"MessageIdWebsitesMessages" toml:"MessageIdWebsitesMessages" yaml:"MessageIdWebsitesMessages"`
 }
 
 // NewStruct creates a new relationship struct
@@ -139,6 +186,34 @@ func (r *messageR) GetUserIdUser() *User {
 	return r.UserIdUser
 }
 
+func (r *messageR) GetMessageLinkClicks() MessageLinkClickSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MessageLinkClicks
+}
+
+func (r *messageR) GetMessageStats() MessageStatSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MessageStats
+}
+
+func (r *messageR) GetMessageTranslations() MessageTranslationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MessageTranslations
+}
+
+func (r *messageR) GetPreviewTokens() PreviewTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PreviewTokens
+}
+
 func (r *messageR) GetMessageIdWebsitesMessages() WebsitesMessageSlice {
 	if r == nil {
 		return nil
@@ -150,9 +225,9 @@ func (r *messageR) GetMessageIdWebsitesMessages() WebsitesMessageSlice {
 type messageL struct{}
 
 var (
-	messageAllColumns            = []string{"id", "title", "message", "language", "userId", "display_from", "display_to", "created_at", "updated_at", "type"}
-	messageColumnsWithoutDefault = []string{"title", "message", "language", "userId", "display_from", "display_to", "created_at", "updated_at"}
-	messageColumnsWithDefault    = []string{"id", "type"}
+	messageAllColumns            = []string{"id", "userId", "display_from", "display_to", "created_at", "updated_at", "type", "revision", "timezone", "recurrence_rule", "recurrence_exceptions", "recurrence_duration", "daily_from", "daily_to", "weekdays"}
+	messageColumnsWithoutDefault = []string{"userId", "display_from", "display_to", "created_at", "updated_at"}
+	messageColumnsWithDefault    = []string{"id", "type", "revision", "timezone", "recurrence_rule", "recurrence_exceptions", "recurrence_duration", "daily_from", "daily_to", "weekdays"}
 	messagePrimaryKeyColumns     = []string{"id"}
 	messageGeneratedColumns      = []string{"id"}
 )
@@ -473,6 +548,62 @@ func (o *Message) UserIdUser(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// MessageLinkClicks retrieves all the message_link_click's MessageLinkClicks with an executor.
+func (o *Message) MessageLinkClicks(mods ...qm.QueryMod) messageLinkClickQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"message_link_clicks\".\"message_id\"=?", o.ID),
+	)
+
+	return MessageLinkClicks(queryMods...)
+}
+
+// MessageStats retrieves all the message_stat's MessageStats with an executor.
+func (o *Message) MessageStats(mods ...qm.QueryMod) messageStatQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"message_stats\".\"message_id\"=?", o.ID),
+	)
+
+	return MessageStats(queryMods...)
+}
+
+// MessageTranslations retrieves all the message_translation's MessageTranslations with an executor.
+func (o *Message) MessageTranslations(mods ...qm.QueryMod) messageTranslationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"message_translations\".\"message_id\"=?", o.ID),
+	)
+
+	return MessageTranslations(queryMods...)
+}
+
+// PreviewTokens retrieves all the preview_token's PreviewTokens with an executor.
+func (o *Message) PreviewTokens(mods ...qm.QueryMod) previewTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"preview_tokens\".\"message_id\"=?", o.ID),
+	)
+
+	return PreviewTokens(queryMods...)
+}
+
 // MessageIdWebsitesMessages retrieves all the websites_message's WebsitesMessages with an executor via messageId column.
 func (o *Message) MessageIdWebsitesMessages(mods ...qm.QueryMod) websitesMessageQuery {
 	var queryMods []qm.QueryMod
@@ -607,9 +738,9 @@ func (messageL) LoadUserIdUser(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// LoadMessageIdWebsitesMessages allows an eager lookup of values, cached into the
+// LoadMessageLinkClicks allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (messageL) LoadMessageIdWebsitesMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMessage interface{}, mods queries.Applicator) error {
+func (messageL) LoadMessageLinkClicks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMessage interface{}, mods queries.Applicator) error {
 	var slice []*Message
 	var object *Message
 
@@ -662,8 +793,8 @@ func (messageL) LoadMessageIdWebsitesMessages(ctx context.Context, e boil.Contex
 	}
 
 	query := NewQuery(
-		qm.From(`websites_messages`),
-		qm.WhereIn(`websites_messages.messageId in ?`, argsSlice...),
+		qm.From(`message_link_clicks`),
+		qm.WhereIn(`message_link_clicks.message_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -671,22 +802,22 @@ func (messageL) LoadMessageIdWebsitesMessages(ctx context.Context, e boil.Contex
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load websites_messages")
+		return errors.Wrap(err, "failed to eager load message_link_clicks")
 	}
 
-	var resultSlice []*WebsitesMessage
+	var resultSlice []*MessageLinkClick
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice websites_messages")
+		return errors.Wrap(err, "failed to bind eager loaded slice message_link_clicks")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on websites_messages")
+		return errors.Wrap(err, "failed to close results in eager load on message_link_clicks")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites_messages")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for message_link_clicks")
 	}
 
-	if len(websitesMessageAfterSelectHooks) != 0 {
+	if len(messageLinkClickAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -694,24 +825,24 @@ func (messageL) LoadMessageIdWebsitesMessages(ctx context.Context, e boil.Contex
 		}
 	}
 	if singular {
-		object.R.MessageIdWebsitesMessages = resultSlice
+		object.R.MessageLinkClicks = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &websitesMessageR{}
+				foreign.R = &messageLinkClickR{}
 			}
-			foreign.R.MessageIdMessage = object
+			foreign.R.Message = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.MessageId {
-				local.R.MessageIdWebsitesMessages = append(local.R.MessageIdWebsitesMessages, foreign)
+			if local.ID == foreign.MessageID {
+				local.R.MessageLinkClicks = append(local.R.MessageLinkClicks, foreign)
 				if foreign.R == nil {
-					foreign.R = &websitesMessageR{}
+					foreign.R = &messageLinkClickR{}
 				}
-				foreign.R.MessageIdMessage = local
+				foreign.R.Message = local
 				break
 			}
 		}
@@ -720,50 +851,714 @@ func (messageL) LoadMessageIdWebsitesMessages(ctx context.Context, e boil.Contex
 	return nil
 }
 
-// SetUserIdUser of the message to the related item.
-// Sets o.R.UserIdUser to related.
-// Adds o to related.R.UserIdMessages.
-func (o *Message) SetUserIdUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+// LoadMessageStats allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (messageL) LoadMessageStats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMessage interface{}, mods queries.Applicator) error {
+	var slice []*Message
+	var object *Message
+
+	if singular {
+		var ok bool
+		object, ok = maybeMessage.(*Message)
+		if !ok {
+			object = new(Message)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMessage))
+			}
+		}
+	} else {
+		s, ok := maybeMessage.(*[]*Message)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMessage))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"messages\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 0, []string{"userId"}),
-		strmangle.WhereClause("\"", "\"", 0, messagePrimaryKeyColumns),
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &messageR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &messageR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`message_stats`),
+		qm.WhereIn(`message_stats.message_id in ?`, argsSlice...),
 	)
-	values := []interface{}{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load message_stats")
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*MessageStat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice message_stats")
 	}
 
-	o.UserId = related.ID
-	if o.R == nil {
-		o.R = &messageR{
-			UserIdUser: related,
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on message_stats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for message_stats")
+	}
+
+	if len(messageStatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MessageStats = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &messageStatR{}
+			}
+			foreign.R.Message = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.MessageID {
+				local.R.MessageStats = append(local.R.MessageStats, foreign)
+				if foreign.R == nil {
+					foreign.R = &messageStatR{}
+				}
+				foreign.R.Message = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMessageTranslations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (messageL) LoadMessageTranslations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMessage interface{}, mods queries.Applicator) error {
+	var slice []*Message
+	var object *Message
+
+	if singular {
+		var ok bool
+		object, ok = maybeMessage.(*Message)
+		if !ok {
+			object = new(Message)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMessage))
+			}
 		}
 	} else {
-		o.R.UserIdUser = related
+		s, ok := maybeMessage.(*[]*Message)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMessage))
+			}
+		}
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			UserIdMessages: MessageSlice{o},
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &messageR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		related.R.UserIdMessages = append(related.R.UserIdMessages, o)
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &messageR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`message_translations`),
+		qm.WhereIn(`message_translations.message_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load message_translations")
+	}
+
+	var resultSlice []*MessageTranslation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice message_translations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on message_translations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for message_translations")
 	}
 
+	if len(messageTranslationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MessageTranslations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &messageTranslationR{}
+			}
+			foreign.R.Message = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.MessageID {
+				local.R.MessageTranslations = append(local.R.MessageTranslations, foreign)
+				if foreign.R == nil {
+					foreign.R = &messageTranslationR{}
+				}
+				foreign.R.Message = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPreviewTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (messageL) LoadPreviewTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMessage interface{}, mods queries.Applicator) error {
+	var slice []*Message
+	var object *Message
+
+	if singular {
+		var ok bool
+		object, ok = maybeMessage.(*Message)
+		if !ok {
+			object = new(Message)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMessage))
+			}
+		}
+	} else {
+		s, ok := maybeMessage.(*[]*Message)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMessage))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &messageR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &messageR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`preview_tokens`),
+		qm.WhereIn(`preview_tokens.message_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load preview_tokens")
+	}
+
+	var resultSlice []*PreviewToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice preview_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on preview_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for preview_tokens")
+	}
+
+	if len(previewTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PreviewTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &previewTokenR{}
+			}
+			foreign.R.Message = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.MessageID {
+				local.R.PreviewTokens = append(local.R.PreviewTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &previewTokenR{}
+				}
+				foreign.R.Message = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMessageIdWebsitesMessages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (messageL) LoadMessageIdWebsitesMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMessage interface{}, mods queries.Applicator) error {
+	var slice []*Message
+	var object *Message
+
+	if singular {
+		var ok bool
+		object, ok = maybeMessage.(*Message)
+		if !ok {
+			object = new(Message)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMessage))
+			}
+		}
+	} else {
+		s, ok := maybeMessage.(*[]*Message)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMessage))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &messageR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &messageR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites_messages`),
+		qm.WhereIn(`websites_messages.messageId in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load websites_messages")
+	}
+
+	var resultSlice []*WebsitesMessage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice websites_messages")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on websites_messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites_messages")
+	}
+
+	if len(websitesMessageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MessageIdWebsitesMessages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &websitesMessageR{}
+			}
+			foreign.R.MessageIdMessage = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.MessageId {
+				local.R.MessageIdWebsitesMessages = append(local.R.MessageIdWebsitesMessages, foreign)
+				if foreign.R == nil {
+					foreign.R = &websitesMessageR{}
+				}
+				foreign.R.MessageIdMessage = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserIdUser of the message to the related item.
+// Sets o.R.UserIdUser to related.
+// Adds o to related.R.UserIdMessages.
+func (o *Message) SetUserIdUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"messages\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"userId"}),
+		strmangle.WhereClause("\"", "\"", 0, messagePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserId = related.ID
+	if o.R == nil {
+		o.R = &messageR{
+			UserIdUser: related,
+		}
+	} else {
+		o.R.UserIdUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			UserIdMessages: MessageSlice{o},
+		}
+	} else {
+		related.R.UserIdMessages = append(related.R.UserIdMessages, o)
+	}
+
+	return nil
+}
+
+// AddMessageLinkClicks adds the given related objects to the existing relationships
+// of the message, optionally inserting them as new records.
+// Appends related to o.R.MessageLinkClicks.
+// Sets related.R.Message appropriately.
+func (o *Message) AddMessageLinkClicks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MessageLinkClick) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.MessageID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"message_link_clicks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"message_id"}),
+				strmangle.WhereClause("\"", "\"", 0, messageLinkClickPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.MessageID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &messageR{
+			MessageLinkClicks: related,
+		}
+	} else {
+		o.R.MessageLinkClicks = append(o.R.MessageLinkClicks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &messageLinkClickR{
+				Message: o,
+			}
+		} else {
+			rel.R.Message = o
+		}
+	}
+	return nil
+}
+
+// AddMessageStats adds the given related objects to the existing relationships
+// of the message, optionally inserting them as new records.
+// Appends related to o.R.MessageStats.
+// Sets related.R.Message appropriately.
+func (o *Message) AddMessageStats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MessageStat) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.MessageID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"message_stats\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"message_id"}),
+				strmangle.WhereClause("\"", "\"", 0, messageStatPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.MessageID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &messageR{
+			MessageStats: related,
+		}
+	} else {
+		o.R.MessageStats = append(o.R.MessageStats, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &messageStatR{
+				Message: o,
+			}
+		} else {
+			rel.R.Message = o
+		}
+	}
+	return nil
+}
+
+// AddMessageTranslations adds the given related objects to the existing relationships
+// of the message, optionally inserting them as new records.
+// Appends related to o.R.MessageTranslations.
+// Sets related.R.Message appropriately.
+func (o *Message) AddMessageTranslations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MessageTranslation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.MessageID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"message_translations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"message_id"}),
+				strmangle.WhereClause("\"", "\"", 0, messageTranslationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.MessageID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &messageR{
+			MessageTranslations: related,
+		}
+	} else {
+		o.R.MessageTranslations = append(o.R.MessageTranslations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &messageTranslationR{
+				Message: o,
+			}
+		} else {
+			rel.R.Message = o
+		}
+	}
+	return nil
+}
+
+// AddPreviewTokens adds the given related objects to the existing relationships
+// of the message, optionally inserting them as new records.
+// Appends related to o.R.PreviewTokens.
+// Sets related.R.Message appropriately.
+func (o *Message) AddPreviewTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PreviewToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.MessageID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"preview_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"message_id"}),
+				strmangle.WhereClause("\"", "\"", 0, previewTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.MessageID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &messageR{
+			PreviewTokens: related,
+		}
+	} else {
+		o.R.PreviewTokens = append(o.R.PreviewTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &previewTokenR{
+				Message: o,
+			}
+		} else {
+			rel.R.Message = o
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,1434 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PreviewToken is an object representing the database table.
+type PreviewToken struct {
+	ID          int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	MessageID   int64      `boil:"message_id" json:"message_id" toml:"message_id" yaml:"message_id"`
+	WebsiteID   null.Int64 `boil:"website_id" json:"website_id,omitempty" toml:"website_id" yaml:"website_id,omitempty"`
+	PreviewAt   null.Time  `boil:"preview_at" json:"preview_at,omitempty" toml:"preview_at" yaml:"preview_at,omitempty"`
+	TokenPrefix string     `boil:"token_prefix" json:"token_prefix" toml:"token_prefix" yaml:"token_prefix"`
+	TokenHash   string     `boil:"token_hash" json:"token_hash" toml:"token_hash" yaml:"token_hash"`
+	CreatedAt   time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ExpiresAt   time.Time  `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	LastUsedAt  null.Time  `boil:"last_used_at" json:"last_used_at,omitempty" toml:"last_used_at" yaml:"last_used_at,omitempty"`
+	RevokedAt   null.Time  `boil:"revoked_at" json:"revoked_at,omitempty" toml:"revoked_at" yaml:"revoked_at,omitempty"`
+
+	R *previewTokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L previewTokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PreviewTokenColumns = struct {
+	ID          string
+	MessageID   string
+	WebsiteID   string
+	PreviewAt   string
+	TokenPrefix string
+	TokenHash   string
+	CreatedAt   string
+	ExpiresAt   string
+	LastUsedAt  string
+	RevokedAt   string
+}{
+	ID:          "id",
+	MessageID:   "message_id",
+	WebsiteID:   "website_id",
+	PreviewAt:   "preview_at",
+	TokenPrefix: "token_prefix",
+	TokenHash:   "token_hash",
+	CreatedAt:   "created_at",
+	ExpiresAt:   "expires_at",
+	LastUsedAt:  "last_used_at",
+	RevokedAt:   "revoked_at",
+}
+
+var PreviewTokenTableColumns = struct {
+	ID          string
+	MessageID   string
+	WebsiteID   string
+	PreviewAt   string
+	TokenPrefix string
+	TokenHash   string
+	CreatedAt   string
+	ExpiresAt   string
+	LastUsedAt  string
+	RevokedAt   string
+}{
+	ID:          "preview_tokens.id",
+	MessageID:   "preview_tokens.message_id",
+	WebsiteID:   "preview_tokens.website_id",
+	PreviewAt:   "preview_tokens.preview_at",
+	TokenPrefix: "preview_tokens.token_prefix",
+	TokenHash:   "preview_tokens.token_hash",
+	CreatedAt:   "preview_tokens.created_at",
+	ExpiresAt:   "preview_tokens.expires_at",
+	LastUsedAt:  "preview_tokens.last_used_at",
+	RevokedAt:   "preview_tokens.revoked_at",
+}
+
+// Generated where
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var PreviewTokenWhere = struct {
+	ID          whereHelperint64
+	MessageID   whereHelperint64
+	WebsiteID   whereHelpernull_Int64
+	PreviewAt   whereHelpernull_Time
+	TokenPrefix whereHelperstring
+	TokenHash   whereHelperstring
+	CreatedAt   whereHelpertime_Time
+	ExpiresAt   whereHelpertime_Time
+	LastUsedAt  whereHelpernull_Time
+	RevokedAt   whereHelpernull_Time
+}{
+	ID:          whereHelperint64{field: "\"preview_tokens\".\"id\""},
+	MessageID:   whereHelperint64{field: "\"preview_tokens\".\"message_id\""},
+	WebsiteID:   whereHelpernull_Int64{field: "\"preview_tokens\".\"website_id\""},
+	PreviewAt:   whereHelpernull_Time{field: "\"preview_tokens\".\"preview_at\""},
+	TokenPrefix: whereHelperstring{field: "\"preview_tokens\".\"token_prefix\""},
+	TokenHash:   whereHelperstring{field: "\"preview_tokens\".\"token_hash\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"preview_tokens\".\"created_at\""},
+	ExpiresAt:   whereHelpertime_Time{field: "\"preview_tokens\".\"expires_at\""},
+	LastUsedAt:  whereHelpernull_Time{field: "\"preview_tokens\".\"last_used_at\""},
+	RevokedAt:   whereHelpernull_Time{field: "\"preview_tokens\".\"revoked_at\""},
+}
+
+// PreviewTokenRels is where relationship names are stored.
+var PreviewTokenRels = struct {
+	Website string
+	Message string
+}{
+	Website: "Website",
+	Message: "Message",
+}
+
+// previewTokenR is where relationships are stored.
+type previewTokenR struct {
+	Website *Website `boil:"Website" json:"Website" toml:"Website" yaml:"Website"`
+	Message *Message `boil:"Message" json:"Message" toml:"Message" yaml:"Message"`
+}
+
+// NewStruct creates a new relationship struct
+func (*previewTokenR) NewStruct() *previewTokenR {
+	return &previewTokenR{}
+}
+
+func (r *previewTokenR) GetWebsite() *Website {
+	if r == nil {
+		return nil
+	}
+	return r.Website
+}
+
+func (r *previewTokenR) GetMessage() *Message {
+	if r == nil {
+		return nil
+	}
+	return r.Message
+}
+
+// previewTokenL is where Load methods for each relationship are stored.
+type previewTokenL struct{}
+
+var (
+	previewTokenAllColumns            = []string{"id", "message_id", "website_id", "preview_at", "token_prefix", "token_hash", "created_at", "expires_at", "last_used_at", "revoked_at"}
+	previewTokenColumnsWithoutDefault = []string{"message_id", "token_prefix", "token_hash", "created_at", "expires_at"}
+	previewTokenColumnsWithDefault    = []string{"id", "website_id", "preview_at", "last_used_at", "revoked_at"}
+	previewTokenPrimaryKeyColumns     = []string{"id"}
+	previewTokenGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// PreviewTokenSlice is an alias for a slice of pointers to PreviewToken.
+	// This should almost always be used instead of []PreviewToken.
+	PreviewTokenSlice []*PreviewToken
+	// PreviewTokenHook is the signature for custom PreviewToken hook methods
+	PreviewTokenHook func(context.Context, boil.ContextExecutor, *PreviewToken) error
+
+	previewTokenQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	previewTokenType                 = reflect.TypeOf(&PreviewToken{})
+	previewTokenMapping              = queries.MakeStructMapping(previewTokenType)
+	previewTokenPrimaryKeyMapping, _ = queries.BindMapping(previewTokenType, previewTokenMapping, previewTokenPrimaryKeyColumns)
+	previewTokenInsertCacheMut       sync.RWMutex
+	previewTokenInsertCache          = make(map[string]insertCache)
+	previewTokenUpdateCacheMut       sync.RWMutex
+	previewTokenUpdateCache          = make(map[string]updateCache)
+	previewTokenUpsertCacheMut       sync.RWMutex
+	previewTokenUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var previewTokenAfterSelectMu sync.Mutex
+var previewTokenAfterSelectHooks []PreviewTokenHook
+
+var previewTokenBeforeInsertMu sync.Mutex
+var previewTokenBeforeInsertHooks []PreviewTokenHook
+var previewTokenAfterInsertMu sync.Mutex
+var previewTokenAfterInsertHooks []PreviewTokenHook
+
+var previewTokenBeforeUpdateMu sync.Mutex
+var previewTokenBeforeUpdateHooks []PreviewTokenHook
+var previewTokenAfterUpdateMu sync.Mutex
+var previewTokenAfterUpdateHooks []PreviewTokenHook
+
+var previewTokenBeforeDeleteMu sync.Mutex
+var previewTokenBeforeDeleteHooks []PreviewTokenHook
+var previewTokenAfterDeleteMu sync.Mutex
+var previewTokenAfterDeleteHooks []PreviewTokenHook
+
+var previewTokenBeforeUpsertMu sync.Mutex
+var previewTokenBeforeUpsertHooks []PreviewTokenHook
+var previewTokenAfterUpsertMu sync.Mutex
+var previewTokenAfterUpsertHooks []PreviewTokenHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PreviewToken) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range previewTokenAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PreviewToken) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range previewTokenBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PreviewToken) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range previewTokenAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PreviewToken) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range previewTokenBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PreviewToken) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range previewTokenAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PreviewToken) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range previewTokenBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PreviewToken) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range previewTokenAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PreviewToken) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range previewTokenBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PreviewToken) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range previewTokenAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPreviewTokenHook registers your hook function for all future operations.
+func AddPreviewTokenHook(hookPoint boil.HookPoint, previewTokenHook PreviewTokenHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		previewTokenAfterSelectMu.Lock()
+		previewTokenAfterSelectHooks = append(previewTokenAfterSelectHooks, previewTokenHook)
+		previewTokenAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		previewTokenBeforeInsertMu.Lock()
+		previewTokenBeforeInsertHooks = append(previewTokenBeforeInsertHooks, previewTokenHook)
+		previewTokenBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		previewTokenAfterInsertMu.Lock()
+		previewTokenAfterInsertHooks = append(previewTokenAfterInsertHooks, previewTokenHook)
+		previewTokenAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		previewTokenBeforeUpdateMu.Lock()
+		previewTokenBeforeUpdateHooks = append(previewTokenBeforeUpdateHooks, previewTokenHook)
+		previewTokenBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		previewTokenAfterUpdateMu.Lock()
+		previewTokenAfterUpdateHooks = append(previewTokenAfterUpdateHooks, previewTokenHook)
+		previewTokenAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		previewTokenBeforeDeleteMu.Lock()
+		previewTokenBeforeDeleteHooks = append(previewTokenBeforeDeleteHooks, previewTokenHook)
+		previewTokenBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		previewTokenAfterDeleteMu.Lock()
+		previewTokenAfterDeleteHooks = append(previewTokenAfterDeleteHooks, previewTokenHook)
+		previewTokenAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		previewTokenBeforeUpsertMu.Lock()
+		previewTokenBeforeUpsertHooks = append(previewTokenBeforeUpsertHooks, previewTokenHook)
+		previewTokenBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		previewTokenAfterUpsertMu.Lock()
+		previewTokenAfterUpsertHooks = append(previewTokenAfterUpsertHooks, previewTokenHook)
+		previewTokenAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single previewToken record from the query.
+func (q previewTokenQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PreviewToken, error) {
+	o := &PreviewToken{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for preview_tokens")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PreviewToken records from the query.
+func (q previewTokenQuery) All(ctx context.Context, exec boil.ContextExecutor) (PreviewTokenSlice, error) {
+	var o []*PreviewToken
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PreviewToken slice")
+	}
+
+	if len(previewTokenAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PreviewToken records in the query.
+func (q previewTokenQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count preview_tokens rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q previewTokenQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if preview_tokens exists")
+	}
+
+	return count > 0, nil
+}
+
+// Website pointed to by the foreign key.
+func (o *PreviewToken) Website(mods ...qm.QueryMod) websiteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WebsiteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Websites(queryMods...)
+}
+
+// Message pointed to by the foreign key.
+func (o *PreviewToken) Message(mods ...qm.QueryMod) messageQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.MessageID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Messages(queryMods...)
+}
+
+// LoadWebsite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (previewTokenL) LoadWebsite(ctx context.Context, e boil.ContextExecutor, singular bool, maybePreviewToken interface{}, mods queries.Applicator) error {
+	var slice []*PreviewToken
+	var object *PreviewToken
+
+	if singular {
+		var ok bool
+		object, ok = maybePreviewToken.(*PreviewToken)
+		if !ok {
+			object = new(PreviewToken)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePreviewToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePreviewToken))
+			}
+		}
+	} else {
+		s, ok := maybePreviewToken.(*[]*PreviewToken)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePreviewToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePreviewToken))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &previewTokenR{}
+		}
+		if !queries.IsNil(object.WebsiteID) {
+			args[object.WebsiteID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &previewTokenR{}
+			}
+
+			if !queries.IsNil(obj.WebsiteID) {
+				args[obj.WebsiteID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites`),
+		qm.WhereIn(`websites.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Website")
+	}
+
+	var resultSlice []*Website
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Website")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for websites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
+	}
+
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Website = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteR{}
+		}
+		foreign.R.PreviewTokens = append(foreign.R.PreviewTokens, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.WebsiteID, foreign.ID) {
+				local.R.Website = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.PreviewTokens = append(foreign.R.PreviewTokens, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMessage allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (previewTokenL) LoadMessage(ctx context.Context, e boil.ContextExecutor, singular bool, maybePreviewToken interface{}, mods queries.Applicator) error {
+	var slice []*PreviewToken
+	var object *PreviewToken
+
+	if singular {
+		var ok bool
+		object, ok = maybePreviewToken.(*PreviewToken)
+		if !ok {
+			object = new(PreviewToken)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePreviewToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePreviewToken))
+			}
+		}
+	} else {
+		s, ok := maybePreviewToken.(*[]*PreviewToken)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePreviewToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePreviewToken))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &previewTokenR{}
+		}
+		args[object.MessageID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &previewTokenR{}
+			}
+
+			args[obj.MessageID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`messages`),
+		qm.WhereIn(`messages.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Message")
+	}
+
+	var resultSlice []*Message
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Message")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for messages")
+	}
+
+	if len(messageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Message = foreign
+		if foreign.R == nil {
+			foreign.R = &messageR{}
+		}
+		foreign.R.PreviewTokens = append(foreign.R.PreviewTokens, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.MessageID == foreign.ID {
+				local.R.Message = foreign
+				if foreign.R == nil {
+					foreign.R = &messageR{}
+				}
+				foreign.R.PreviewTokens = append(foreign.R.PreviewTokens, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebsite of the previewToken to the related item.
+// Sets o.R.Website to related.
+// Adds o to related.R.PreviewTokens.
+func (o *PreviewToken) SetWebsite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Website) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"preview_tokens\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"website_id"}),
+		strmangle.WhereClause("\"", "\"", 0, previewTokenPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.WebsiteID, related.ID)
+	if o.R == nil {
+		o.R = &previewTokenR{
+			Website: related,
+		}
+	} else {
+		o.R.Website = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteR{
+			PreviewTokens: PreviewTokenSlice{o},
+		}
+	} else {
+		related.R.PreviewTokens = append(related.R.PreviewTokens, o)
+	}
+
+	return nil
+}
+
+// RemoveWebsite relationship.
+// Sets o.R.Website to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *PreviewToken) RemoveWebsite(ctx context.Context, exec boil.ContextExecutor, related *Website) error {
+	var err error
+
+	queries.SetScanner(&o.WebsiteID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("website_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Website = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.PreviewTokens {
+		if queries.Equal(o.WebsiteID, ri.WebsiteID) {
+			continue
+		}
+
+		ln := len(related.R.PreviewTokens)
+		if ln > 1 && i < ln-1 {
+			related.R.PreviewTokens[i] = related.R.PreviewTokens[ln-1]
+		}
+		related.R.PreviewTokens = related.R.PreviewTokens[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetMessage of the previewToken to the related item.
+// Sets o.R.Message to related.
+// Adds o to related.R.PreviewTokens.
+func (o *PreviewToken) SetMessage(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Message) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"preview_tokens\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"message_id"}),
+		strmangle.WhereClause("\"", "\"", 0, previewTokenPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.MessageID = related.ID
+	if o.R == nil {
+		o.R = &previewTokenR{
+			Message: related,
+		}
+	} else {
+		o.R.Message = related
+	}
+
+	if related.R == nil {
+		related.R = &messageR{
+			PreviewTokens: PreviewTokenSlice{o},
+		}
+	} else {
+		related.R.PreviewTokens = append(related.R.PreviewTokens, o)
+	}
+
+	return nil
+}
+
+// PreviewTokens retrieves all the records using an executor.
+func PreviewTokens(mods ...qm.QueryMod) previewTokenQuery {
+	mods = append(mods, qm.From("\"preview_tokens\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"preview_tokens\".*"})
+	}
+
+	return previewTokenQuery{q}
+}
+
+// FindPreviewToken retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPreviewToken(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*PreviewToken, error) {
+	previewTokenObj := &PreviewToken{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"preview_tokens\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, previewTokenObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from preview_tokens")
+	}
+
+	if err = previewTokenObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return previewTokenObj, err
+	}
+
+	return previewTokenObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PreviewToken) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no preview_tokens provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(previewTokenColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	previewTokenInsertCacheMut.RLock()
+	cache, cached := previewTokenInsertCache[key]
+	previewTokenInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			previewTokenAllColumns,
+			previewTokenColumnsWithDefault,
+			previewTokenColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, previewTokenGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(previewTokenType, previewTokenMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(previewTokenType, previewTokenMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"preview_tokens\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"preview_tokens\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into preview_tokens")
+	}
+
+	if !cached {
+		previewTokenInsertCacheMut.Lock()
+		previewTokenInsertCache[key] = cache
+		previewTokenInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PreviewToken.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PreviewToken) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	previewTokenUpdateCacheMut.RLock()
+	cache, cached := previewTokenUpdateCache[key]
+	previewTokenUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			previewTokenAllColumns,
+			previewTokenPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, previewTokenGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update preview_tokens, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"preview_tokens\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, previewTokenPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(previewTokenType, previewTokenMapping, append(wl, previewTokenPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update preview_tokens row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for preview_tokens")
+	}
+
+	if !cached {
+		previewTokenUpdateCacheMut.Lock()
+		previewTokenUpdateCache[key] = cache
+		previewTokenUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q previewTokenQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for preview_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for preview_tokens")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PreviewTokenSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), previewTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"preview_tokens\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, previewTokenPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in previewToken slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all previewToken")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PreviewToken) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no preview_tokens provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(previewTokenColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	previewTokenUpsertCacheMut.RLock()
+	cache, cached := previewTokenUpsertCache[key]
+	previewTokenUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			previewTokenAllColumns,
+			previewTokenColumnsWithDefault,
+			previewTokenColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			previewTokenAllColumns,
+			previewTokenPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert preview_tokens, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(previewTokenAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(previewTokenPrimaryKeyColumns))
+			copy(conflict, previewTokenPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"preview_tokens\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(previewTokenType, previewTokenMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(previewTokenType, previewTokenMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert preview_tokens")
+	}
+
+	if !cached {
+		previewTokenUpsertCacheMut.Lock()
+		previewTokenUpsertCache[key] = cache
+		previewTokenUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PreviewToken record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PreviewToken) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PreviewToken provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), previewTokenPrimaryKeyMapping)
+	sql := "DELETE FROM \"preview_tokens\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from preview_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for preview_tokens")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q previewTokenQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no previewTokenQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from preview_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for preview_tokens")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PreviewTokenSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(previewTokenBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), previewTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"preview_tokens\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, previewTokenPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from previewToken slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for preview_tokens")
+	}
+
+	if len(previewTokenAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PreviewToken) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPreviewToken(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PreviewTokenSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PreviewTokenSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), previewTokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"preview_tokens\".* FROM \"preview_tokens\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, previewTokenPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PreviewTokenSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PreviewTokenExists checks if the PreviewToken row exists.
+func PreviewTokenExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"preview_tokens\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if preview_tokens exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PreviewToken row exists.
+func (o *PreviewToken) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PreviewTokenExists(ctx, exec, o.ID)
+}
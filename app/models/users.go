@@ -33,6 +33,7 @@ type User struct {
 	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt       time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	Role            string    `boil:"role" json:"role" toml:"role" yaml:"role"`
+	Timezone        string    `boil:"timezone" json:"timezone" toml:"timezone" yaml:"timezone"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -48,6 +49,7 @@ var UserColumns = struct {
 	CreatedAt       string
 	UpdatedAt       string
 	Role            string
+	Timezone        string
 }{
 	ID:              "id",
 	Email:           "email",
@@ -58,6 +60,7 @@ var UserColumns = struct {
 	CreatedAt:       "created_at",
 	UpdatedAt:       "updated_at",
 	Role:            "role",
+	Timezone:        "timezone",
 }
 
 var UserTableColumns = struct {
@@ -70,6 +73,7 @@ var UserTableColumns = struct {
 	CreatedAt       string
 	UpdatedAt       string
 	Role            string
+	Timezone        string
 }{
 	ID:              "users.id",
 	Email:           "users.email",
@@ -80,34 +84,11 @@ var UserTableColumns = struct {
 	CreatedAt:       "users.created_at",
 	UpdatedAt:       "users.updated_at",
 	Role:            "users.role",
+	Timezone:        "users.timezone",
 }
 
 // Generated where
 
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var UserWhere = struct {
 	ID              whereHelperint64
 	Email           whereHelperstring
@@ -118,6 +99,7 @@ var UserWhere = struct {
 	CreatedAt       whereHelpertime_Time
 	UpdatedAt       whereHelpertime_Time
 	Role            whereHelperstring
+	Timezone        whereHelperstring
 }{
 	ID:              whereHelperint64{field: "\"users\".\"id\""},
 	Email:           whereHelperstring{field: "\"users\".\"email\""},
@@ -128,6 +110,7 @@ var UserWhere = struct {
 	CreatedAt:       whereHelpertime_Time{field: "\"users\".\"created_at\""},
 	UpdatedAt:       whereHelpertime_Time{field: "\"users\".\"updated_at\""},
 	Role:            whereHelperstring{field: "\"users\".\"role\""},
+	Timezone:        whereHelperstring{field: "\"users\".\"timezone\""},
 }
 
 // UserRels is where relationship names are stored.
@@ -178,9 +161,9 @@ func (r *userR) GetSessions() SessionSlice {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "email", "password_hash", "first_name", "last_name", "email_verified_at", "created_at", "updated_at", "role"}
+	userAllColumns            = []string{"id", "email", "password_hash", "first_name", "last_name", "email_verified_at", "created_at", "updated_at", "role", "timezone"}
 	userColumnsWithoutDefault = []string{"email", "password_hash", "first_name", "last_name", "created_at", "updated_at"}
-	userColumnsWithDefault    = []string{"id", "email_verified_at", "role"}
+	userColumnsWithDefault    = []string{"id", "email_verified_at", "role", "timezone"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{"id"}
 )
@@ -0,0 +1,1171 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WebsiteAPIKey is an object representing the database table.
+type WebsiteAPIKey struct {
+	ID         int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	WebsiteID  int64     `boil:"website_id" json:"website_id" toml:"website_id" yaml:"website_id"`
+	Name       string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	KeyPrefix  string    `boil:"key_prefix" json:"key_prefix" toml:"key_prefix" yaml:"key_prefix"`
+	KeyHash    string    `boil:"key_hash" json:"key_hash" toml:"key_hash" yaml:"key_hash"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	LastUsedAt null.Time `boil:"last_used_at" json:"last_used_at,omitempty" toml:"last_used_at" yaml:"last_used_at,omitempty"`
+	RevokedAt  null.Time `boil:"revoked_at" json:"revoked_at,omitempty" toml:"revoked_at" yaml:"revoked_at,omitempty"`
+
+	R *websiteAPIKeyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L websiteAPIKeyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebsiteAPIKeyColumns = struct {
+	ID         string
+	WebsiteID  string
+	Name       string
+	KeyPrefix  string
+	KeyHash    string
+	CreatedAt  string
+	LastUsedAt string
+	RevokedAt  string
+}{
+	ID:         "id",
+	WebsiteID:  "website_id",
+	Name:       "name",
+	KeyPrefix:  "key_prefix",
+	KeyHash:    "key_hash",
+	CreatedAt:  "created_at",
+	LastUsedAt: "last_used_at",
+	RevokedAt:  "revoked_at",
+}
+
+var WebsiteAPIKeyTableColumns = struct {
+	ID         string
+	WebsiteID  string
+	Name       string
+	KeyPrefix  string
+	KeyHash    string
+	CreatedAt  string
+	LastUsedAt string
+	RevokedAt  string
+}{
+	ID:         "website_api_keys.id",
+	WebsiteID:  "website_api_keys.website_id",
+	Name:       "website_api_keys.name",
+	KeyPrefix:  "website_api_keys.key_prefix",
+	KeyHash:    "website_api_keys.key_hash",
+	CreatedAt:  "website_api_keys.created_at",
+	LastUsedAt: "website_api_keys.last_used_at",
+	RevokedAt:  "website_api_keys.revoked_at",
+}
+
+// Generated where
+
+var WebsiteAPIKeyWhere = struct {
+	ID         whereHelperint64
+	WebsiteID  whereHelperint64
+	Name       whereHelperstring
+	KeyPrefix  whereHelperstring
+	KeyHash    whereHelperstring
+	CreatedAt  whereHelpertime_Time
+	LastUsedAt whereHelpernull_Time
+	RevokedAt  whereHelpernull_Time
+}{
+	ID:         whereHelperint64{field: "\"website_api_keys\".\"id\""},
+	WebsiteID:  whereHelperint64{field: "\"website_api_keys\".\"website_id\""},
+	Name:       whereHelperstring{field: "\"website_api_keys\".\"name\""},
+	KeyPrefix:  whereHelperstring{field: "\"website_api_keys\".\"key_prefix\""},
+	KeyHash:    whereHelperstring{field: "\"website_api_keys\".\"key_hash\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"website_api_keys\".\"created_at\""},
+	LastUsedAt: whereHelpernull_Time{field: "\"website_api_keys\".\"last_used_at\""},
+	RevokedAt:  whereHelpernull_Time{field: "\"website_api_keys\".\"revoked_at\""},
+}
+
+// WebsiteAPIKeyRels is where relationship names are stored.
+var WebsiteAPIKeyRels = struct {
+	Website string
+}{
+	Website: "Website",
+}
+
+// websiteAPIKeyR is where relationships are stored.
+type websiteAPIKeyR struct {
+	Website *Website `boil:"Website" json:"Website" toml:"Website" yaml:"Website"`
+}
+
+// NewStruct creates a new relationship struct
+func (*websiteAPIKeyR) NewStruct() *websiteAPIKeyR {
+	return &websiteAPIKeyR{}
+}
+
+func (r *websiteAPIKeyR) GetWebsite() *Website {
+	if r == nil {
+		return nil
+	}
+	return r.Website
+}
+
+// websiteAPIKeyL is where Load methods for each relationship are stored.
+type websiteAPIKeyL struct{}
+
+var (
+	websiteAPIKeyAllColumns            = []string{"id", "website_id", "name", "key_prefix", "key_hash", "created_at", "last_used_at", "revoked_at"}
+	websiteAPIKeyColumnsWithoutDefault = []string{"website_id", "name", "key_prefix", "key_hash", "created_at"}
+	websiteAPIKeyColumnsWithDefault    = []string{"id", "last_used_at", "revoked_at"}
+	websiteAPIKeyPrimaryKeyColumns     = []string{"id"}
+	websiteAPIKeyGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// WebsiteAPIKeySlice is an alias for a slice of pointers to WebsiteAPIKey.
+	// This should almost always be used instead of []WebsiteAPIKey.
+	WebsiteAPIKeySlice []*WebsiteAPIKey
+	// WebsiteAPIKeyHook is the signature for custom WebsiteAPIKey hook methods
+	WebsiteAPIKeyHook func(context.Context, boil.ContextExecutor, *WebsiteAPIKey) error
+
+	websiteAPIKeyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	websiteAPIKeyType                 = reflect.TypeOf(&WebsiteAPIKey{})
+	websiteAPIKeyMapping              = queries.MakeStructMapping(websiteAPIKeyType)
+	websiteAPIKeyPrimaryKeyMapping, _ = queries.BindMapping(websiteAPIKeyType, websiteAPIKeyMapping, websiteAPIKeyPrimaryKeyColumns)
+	websiteAPIKeyInsertCacheMut       sync.RWMutex
+	websiteAPIKeyInsertCache          = make(map[string]insertCache)
+	websiteAPIKeyUpdateCacheMut       sync.RWMutex
+	websiteAPIKeyUpdateCache          = make(map[string]updateCache)
+	websiteAPIKeyUpsertCacheMut       sync.RWMutex
+	websiteAPIKeyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var websiteAPIKeyAfterSelectMu sync.Mutex
+var websiteAPIKeyAfterSelectHooks []WebsiteAPIKeyHook
+
+var websiteAPIKeyBeforeInsertMu sync.Mutex
+var websiteAPIKeyBeforeInsertHooks []WebsiteAPIKeyHook
+var websiteAPIKeyAfterInsertMu sync.Mutex
+var websiteAPIKeyAfterInsertHooks []WebsiteAPIKeyHook
+
+var websiteAPIKeyBeforeUpdateMu sync.Mutex
+var websiteAPIKeyBeforeUpdateHooks []WebsiteAPIKeyHook
+var websiteAPIKeyAfterUpdateMu sync.Mutex
+var websiteAPIKeyAfterUpdateHooks []WebsiteAPIKeyHook
+
+var websiteAPIKeyBeforeDeleteMu sync.Mutex
+var websiteAPIKeyBeforeDeleteHooks []WebsiteAPIKeyHook
+var websiteAPIKeyAfterDeleteMu sync.Mutex
+var websiteAPIKeyAfterDeleteHooks []WebsiteAPIKeyHook
+
+var websiteAPIKeyBeforeUpsertMu sync.Mutex
+var websiteAPIKeyBeforeUpsertHooks []WebsiteAPIKeyHook
+var websiteAPIKeyAfterUpsertMu sync.Mutex
+var websiteAPIKeyAfterUpsertHooks []WebsiteAPIKeyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebsiteAPIKey) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteAPIKeyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebsiteAPIKey) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteAPIKeyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebsiteAPIKey) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteAPIKeyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebsiteAPIKey) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteAPIKeyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebsiteAPIKey) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteAPIKeyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebsiteAPIKey) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteAPIKeyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebsiteAPIKey) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteAPIKeyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebsiteAPIKey) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteAPIKeyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebsiteAPIKey) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteAPIKeyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebsiteAPIKeyHook registers your hook function for all future operations.
+func AddWebsiteAPIKeyHook(hookPoint boil.HookPoint, websiteAPIKeyHook WebsiteAPIKeyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		websiteAPIKeyAfterSelectMu.Lock()
+		websiteAPIKeyAfterSelectHooks = append(websiteAPIKeyAfterSelectHooks, websiteAPIKeyHook)
+		websiteAPIKeyAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		websiteAPIKeyBeforeInsertMu.Lock()
+		websiteAPIKeyBeforeInsertHooks = append(websiteAPIKeyBeforeInsertHooks, websiteAPIKeyHook)
+		websiteAPIKeyBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		websiteAPIKeyAfterInsertMu.Lock()
+		websiteAPIKeyAfterInsertHooks = append(websiteAPIKeyAfterInsertHooks, websiteAPIKeyHook)
+		websiteAPIKeyAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		websiteAPIKeyBeforeUpdateMu.Lock()
+		websiteAPIKeyBeforeUpdateHooks = append(websiteAPIKeyBeforeUpdateHooks, websiteAPIKeyHook)
+		websiteAPIKeyBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		websiteAPIKeyAfterUpdateMu.Lock()
+		websiteAPIKeyAfterUpdateHooks = append(websiteAPIKeyAfterUpdateHooks, websiteAPIKeyHook)
+		websiteAPIKeyAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		websiteAPIKeyBeforeDeleteMu.Lock()
+		websiteAPIKeyBeforeDeleteHooks = append(websiteAPIKeyBeforeDeleteHooks, websiteAPIKeyHook)
+		websiteAPIKeyBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		websiteAPIKeyAfterDeleteMu.Lock()
+		websiteAPIKeyAfterDeleteHooks = append(websiteAPIKeyAfterDeleteHooks, websiteAPIKeyHook)
+		websiteAPIKeyAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		websiteAPIKeyBeforeUpsertMu.Lock()
+		websiteAPIKeyBeforeUpsertHooks = append(websiteAPIKeyBeforeUpsertHooks, websiteAPIKeyHook)
+		websiteAPIKeyBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		websiteAPIKeyAfterUpsertMu.Lock()
+		websiteAPIKeyAfterUpsertHooks = append(websiteAPIKeyAfterUpsertHooks, websiteAPIKeyHook)
+		websiteAPIKeyAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single websiteAPIKey record from the query.
+func (q websiteAPIKeyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebsiteAPIKey, error) {
+	o := &WebsiteAPIKey{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for website_api_keys")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebsiteAPIKey records from the query.
+func (q websiteAPIKeyQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebsiteAPIKeySlice, error) {
+	var o []*WebsiteAPIKey
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebsiteAPIKey slice")
+	}
+
+	if len(websiteAPIKeyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebsiteAPIKey records in the query.
+func (q websiteAPIKeyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count website_api_keys rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q websiteAPIKeyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if website_api_keys exists")
+	}
+
+	return count > 0, nil
+}
+
+// Website pointed to by the foreign key.
+func (o *WebsiteAPIKey) Website(mods ...qm.QueryMod) websiteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WebsiteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Websites(queryMods...)
+}
+
+// LoadWebsite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (websiteAPIKeyL) LoadWebsite(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsiteAPIKey interface{}, mods queries.Applicator) error {
+	var slice []*WebsiteAPIKey
+	var object *WebsiteAPIKey
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsiteAPIKey.(*WebsiteAPIKey)
+		if !ok {
+			object = new(WebsiteAPIKey)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsiteAPIKey)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsiteAPIKey))
+			}
+		}
+	} else {
+		s, ok := maybeWebsiteAPIKey.(*[]*WebsiteAPIKey)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsiteAPIKey)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsiteAPIKey))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteAPIKeyR{}
+		}
+		args[object.WebsiteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteAPIKeyR{}
+			}
+
+			args[obj.WebsiteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites`),
+		qm.WhereIn(`websites.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Website")
+	}
+
+	var resultSlice []*Website
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Website")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for websites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
+	}
+
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Website = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteR{}
+		}
+		foreign.R.WebsiteAPIKeys = append(foreign.R.WebsiteAPIKeys, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebsiteID == foreign.ID {
+				local.R.Website = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.WebsiteAPIKeys = append(foreign.R.WebsiteAPIKeys, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebsite of the websiteAPIKey to the related item.
+// Sets o.R.Website to related.
+// Adds o to related.R.WebsiteAPIKeys.
+func (o *WebsiteAPIKey) SetWebsite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Website) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"website_api_keys\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"website_id"}),
+		strmangle.WhereClause("\"", "\"", 0, websiteAPIKeyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebsiteID = related.ID
+	if o.R == nil {
+		o.R = &websiteAPIKeyR{
+			Website: related,
+		}
+	} else {
+		o.R.Website = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteR{
+			WebsiteAPIKeys: WebsiteAPIKeySlice{o},
+		}
+	} else {
+		related.R.WebsiteAPIKeys = append(related.R.WebsiteAPIKeys, o)
+	}
+
+	return nil
+}
+
+// WebsiteAPIKeys retrieves all the records using an executor.
+func WebsiteAPIKeys(mods ...qm.QueryMod) websiteAPIKeyQuery {
+	mods = append(mods, qm.From("\"website_api_keys\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"website_api_keys\".*"})
+	}
+
+	return websiteAPIKeyQuery{q}
+}
+
+// FindWebsiteAPIKey retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebsiteAPIKey(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*WebsiteAPIKey, error) {
+	websiteAPIKeyObj := &WebsiteAPIKey{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"website_api_keys\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, websiteAPIKeyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from website_api_keys")
+	}
+
+	if err = websiteAPIKeyObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return websiteAPIKeyObj, err
+	}
+
+	return websiteAPIKeyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebsiteAPIKey) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_api_keys provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteAPIKeyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	websiteAPIKeyInsertCacheMut.RLock()
+	cache, cached := websiteAPIKeyInsertCache[key]
+	websiteAPIKeyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			websiteAPIKeyAllColumns,
+			websiteAPIKeyColumnsWithDefault,
+			websiteAPIKeyColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, websiteAPIKeyGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(websiteAPIKeyType, websiteAPIKeyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(websiteAPIKeyType, websiteAPIKeyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"website_api_keys\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"website_api_keys\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into website_api_keys")
+	}
+
+	if !cached {
+		websiteAPIKeyInsertCacheMut.Lock()
+		websiteAPIKeyInsertCache[key] = cache
+		websiteAPIKeyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebsiteAPIKey.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebsiteAPIKey) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	websiteAPIKeyUpdateCacheMut.RLock()
+	cache, cached := websiteAPIKeyUpdateCache[key]
+	websiteAPIKeyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			websiteAPIKeyAllColumns,
+			websiteAPIKeyPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, websiteAPIKeyGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update website_api_keys, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"website_api_keys\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, websiteAPIKeyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(websiteAPIKeyType, websiteAPIKeyMapping, append(wl, websiteAPIKeyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update website_api_keys row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for website_api_keys")
+	}
+
+	if !cached {
+		websiteAPIKeyUpdateCacheMut.Lock()
+		websiteAPIKeyUpdateCache[key] = cache
+		websiteAPIKeyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q websiteAPIKeyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for website_api_keys")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for website_api_keys")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebsiteAPIKeySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteAPIKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"website_api_keys\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteAPIKeyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in websiteAPIKey slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all websiteAPIKey")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebsiteAPIKey) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_api_keys provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteAPIKeyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	websiteAPIKeyUpsertCacheMut.RLock()
+	cache, cached := websiteAPIKeyUpsertCache[key]
+	websiteAPIKeyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			websiteAPIKeyAllColumns,
+			websiteAPIKeyColumnsWithDefault,
+			websiteAPIKeyColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			websiteAPIKeyAllColumns,
+			websiteAPIKeyPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert website_api_keys, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(websiteAPIKeyAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(websiteAPIKeyPrimaryKeyColumns))
+			copy(conflict, websiteAPIKeyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"website_api_keys\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(websiteAPIKeyType, websiteAPIKeyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(websiteAPIKeyType, websiteAPIKeyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert website_api_keys")
+	}
+
+	if !cached {
+		websiteAPIKeyUpsertCacheMut.Lock()
+		websiteAPIKeyUpsertCache[key] = cache
+		websiteAPIKeyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebsiteAPIKey record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebsiteAPIKey) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebsiteAPIKey provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), websiteAPIKeyPrimaryKeyMapping)
+	sql := "DELETE FROM \"website_api_keys\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from website_api_keys")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for website_api_keys")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q websiteAPIKeyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no websiteAPIKeyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from website_api_keys")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_api_keys")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebsiteAPIKeySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(websiteAPIKeyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteAPIKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"website_api_keys\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteAPIKeyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from websiteAPIKey slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_api_keys")
+	}
+
+	if len(websiteAPIKeyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebsiteAPIKey) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebsiteAPIKey(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebsiteAPIKeySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebsiteAPIKeySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteAPIKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"website_api_keys\".* FROM \"website_api_keys\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteAPIKeyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebsiteAPIKeySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebsiteAPIKeyExists checks if the WebsiteAPIKey row exists.
+func WebsiteAPIKeyExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"website_api_keys\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if website_api_keys exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebsiteAPIKey row exists.
+func (o *WebsiteAPIKey) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebsiteAPIKeyExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1142 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WebsiteDomain is an object representing the database table.
+type WebsiteDomain struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	WebsiteID int64     `boil:"website_id" json:"website_id" toml:"website_id" yaml:"website_id"`
+	Pattern   string    `boil:"pattern" json:"pattern" toml:"pattern" yaml:"pattern"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *websiteDomainR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L websiteDomainL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebsiteDomainColumns = struct {
+	ID        string
+	WebsiteID string
+	Pattern   string
+	CreatedAt string
+}{
+	ID:        "id",
+	WebsiteID: "website_id",
+	Pattern:   "pattern",
+	CreatedAt: "created_at",
+}
+
+var WebsiteDomainTableColumns = struct {
+	ID        string
+	WebsiteID string
+	Pattern   string
+	CreatedAt string
+}{
+	ID:        "website_domains.id",
+	WebsiteID: "website_domains.website_id",
+	Pattern:   "website_domains.pattern",
+	CreatedAt: "website_domains.created_at",
+}
+
+// Generated where
+
+var WebsiteDomainWhere = struct {
+	ID        whereHelperint64
+	WebsiteID whereHelperint64
+	Pattern   whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint64{field: "\"website_domains\".\"id\""},
+	WebsiteID: whereHelperint64{field: "\"website_domains\".\"website_id\""},
+	Pattern:   whereHelperstring{field: "\"website_domains\".\"pattern\""},
+	CreatedAt: whereHelpertime_Time{field: "\"website_domains\".\"created_at\""},
+}
+
+// WebsiteDomainRels is where relationship names are stored.
+var WebsiteDomainRels = struct {
+	Website string
+}{
+	Website: "Website",
+}
+
+// websiteDomainR is where relationships are stored.
+type websiteDomainR struct {
+	Website *Website `boil:"Website" json:"Website" toml:"Website" yaml:"Website"`
+}
+
+// NewStruct creates a new relationship struct
+func (*websiteDomainR) NewStruct() *websiteDomainR {
+	return &websiteDomainR{}
+}
+
+func (r *websiteDomainR) GetWebsite() *Website {
+	if r == nil {
+		return nil
+	}
+	return r.Website
+}
+
+// websiteDomainL is where Load methods for each relationship are stored.
+type websiteDomainL struct{}
+
+var (
+	websiteDomainAllColumns            = []string{"id", "website_id", "pattern", "created_at"}
+	websiteDomainColumnsWithoutDefault = []string{"website_id", "pattern", "created_at"}
+	websiteDomainColumnsWithDefault    = []string{"id"}
+	websiteDomainPrimaryKeyColumns     = []string{"id"}
+	websiteDomainGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// WebsiteDomainSlice is an alias for a slice of pointers to WebsiteDomain.
+	// This should almost always be used instead of []WebsiteDomain.
+	WebsiteDomainSlice []*WebsiteDomain
+	// WebsiteDomainHook is the signature for custom WebsiteDomain hook methods
+	WebsiteDomainHook func(context.Context, boil.ContextExecutor, *WebsiteDomain) error
+
+	websiteDomainQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	websiteDomainType                 = reflect.TypeOf(&WebsiteDomain{})
+	websiteDomainMapping              = queries.MakeStructMapping(websiteDomainType)
+	websiteDomainPrimaryKeyMapping, _ = queries.BindMapping(websiteDomainType, websiteDomainMapping, websiteDomainPrimaryKeyColumns)
+	websiteDomainInsertCacheMut       sync.RWMutex
+	websiteDomainInsertCache          = make(map[string]insertCache)
+	websiteDomainUpdateCacheMut       sync.RWMutex
+	websiteDomainUpdateCache          = make(map[string]updateCache)
+	websiteDomainUpsertCacheMut       sync.RWMutex
+	websiteDomainUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var websiteDomainAfterSelectMu sync.Mutex
+var websiteDomainAfterSelectHooks []WebsiteDomainHook
+
+var websiteDomainBeforeInsertMu sync.Mutex
+var websiteDomainBeforeInsertHooks []WebsiteDomainHook
+var websiteDomainAfterInsertMu sync.Mutex
+var websiteDomainAfterInsertHooks []WebsiteDomainHook
+
+var websiteDomainBeforeUpdateMu sync.Mutex
+var websiteDomainBeforeUpdateHooks []WebsiteDomainHook
+var websiteDomainAfterUpdateMu sync.Mutex
+var websiteDomainAfterUpdateHooks []WebsiteDomainHook
+
+var websiteDomainBeforeDeleteMu sync.Mutex
+var websiteDomainBeforeDeleteHooks []WebsiteDomainHook
+var websiteDomainAfterDeleteMu sync.Mutex
+var websiteDomainAfterDeleteHooks []WebsiteDomainHook
+
+var websiteDomainBeforeUpsertMu sync.Mutex
+var websiteDomainBeforeUpsertHooks []WebsiteDomainHook
+var websiteDomainAfterUpsertMu sync.Mutex
+var websiteDomainAfterUpsertHooks []WebsiteDomainHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebsiteDomain) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteDomainAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebsiteDomain) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteDomainBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebsiteDomain) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteDomainAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebsiteDomain) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteDomainBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebsiteDomain) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteDomainAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebsiteDomain) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteDomainBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebsiteDomain) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteDomainAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebsiteDomain) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteDomainBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebsiteDomain) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteDomainAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebsiteDomainHook registers your hook function for all future operations.
+func AddWebsiteDomainHook(hookPoint boil.HookPoint, websiteDomainHook WebsiteDomainHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		websiteDomainAfterSelectMu.Lock()
+		websiteDomainAfterSelectHooks = append(websiteDomainAfterSelectHooks, websiteDomainHook)
+		websiteDomainAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		websiteDomainBeforeInsertMu.Lock()
+		websiteDomainBeforeInsertHooks = append(websiteDomainBeforeInsertHooks, websiteDomainHook)
+		websiteDomainBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		websiteDomainAfterInsertMu.Lock()
+		websiteDomainAfterInsertHooks = append(websiteDomainAfterInsertHooks, websiteDomainHook)
+		websiteDomainAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		websiteDomainBeforeUpdateMu.Lock()
+		websiteDomainBeforeUpdateHooks = append(websiteDomainBeforeUpdateHooks, websiteDomainHook)
+		websiteDomainBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		websiteDomainAfterUpdateMu.Lock()
+		websiteDomainAfterUpdateHooks = append(websiteDomainAfterUpdateHooks, websiteDomainHook)
+		websiteDomainAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		websiteDomainBeforeDeleteMu.Lock()
+		websiteDomainBeforeDeleteHooks = append(websiteDomainBeforeDeleteHooks, websiteDomainHook)
+		websiteDomainBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		websiteDomainAfterDeleteMu.Lock()
+		websiteDomainAfterDeleteHooks = append(websiteDomainAfterDeleteHooks, websiteDomainHook)
+		websiteDomainAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		websiteDomainBeforeUpsertMu.Lock()
+		websiteDomainBeforeUpsertHooks = append(websiteDomainBeforeUpsertHooks, websiteDomainHook)
+		websiteDomainBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		websiteDomainAfterUpsertMu.Lock()
+		websiteDomainAfterUpsertHooks = append(websiteDomainAfterUpsertHooks, websiteDomainHook)
+		websiteDomainAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single websiteDomain record from the query.
+func (q websiteDomainQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebsiteDomain, error) {
+	o := &WebsiteDomain{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for website_domains")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebsiteDomain records from the query.
+func (q websiteDomainQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebsiteDomainSlice, error) {
+	var o []*WebsiteDomain
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebsiteDomain slice")
+	}
+
+	if len(websiteDomainAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebsiteDomain records in the query.
+func (q websiteDomainQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count website_domains rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q websiteDomainQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if website_domains exists")
+	}
+
+	return count > 0, nil
+}
+
+// Website pointed to by the foreign key.
+func (o *WebsiteDomain) Website(mods ...qm.QueryMod) websiteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WebsiteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Websites(queryMods...)
+}
+
+// LoadWebsite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (websiteDomainL) LoadWebsite(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsiteDomain interface{}, mods queries.Applicator) error {
+	var slice []*WebsiteDomain
+	var object *WebsiteDomain
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsiteDomain.(*WebsiteDomain)
+		if !ok {
+			object = new(WebsiteDomain)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsiteDomain)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsiteDomain))
+			}
+		}
+	} else {
+		s, ok := maybeWebsiteDomain.(*[]*WebsiteDomain)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsiteDomain)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsiteDomain))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteDomainR{}
+		}
+		args[object.WebsiteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteDomainR{}
+			}
+
+			args[obj.WebsiteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites`),
+		qm.WhereIn(`websites.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Website")
+	}
+
+	var resultSlice []*Website
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Website")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for websites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
+	}
+
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Website = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteR{}
+		}
+		foreign.R.WebsiteDomains = append(foreign.R.WebsiteDomains, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebsiteID == foreign.ID {
+				local.R.Website = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.WebsiteDomains = append(foreign.R.WebsiteDomains, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebsite of the websiteDomain to the related item.
+// Sets o.R.Website to related.
+// Adds o to related.R.WebsiteDomains.
+func (o *WebsiteDomain) SetWebsite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Website) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"website_domains\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"website_id"}),
+		strmangle.WhereClause("\"", "\"", 0, websiteDomainPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebsiteID = related.ID
+	if o.R == nil {
+		o.R = &websiteDomainR{
+			Website: related,
+		}
+	} else {
+		o.R.Website = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteR{
+			WebsiteDomains: WebsiteDomainSlice{o},
+		}
+	} else {
+		related.R.WebsiteDomains = append(related.R.WebsiteDomains, o)
+	}
+
+	return nil
+}
+
+// WebsiteDomains retrieves all the records using an executor.
+func WebsiteDomains(mods ...qm.QueryMod) websiteDomainQuery {
+	mods = append(mods, qm.From("\"website_domains\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"website_domains\".*"})
+	}
+
+	return websiteDomainQuery{q}
+}
+
+// FindWebsiteDomain retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebsiteDomain(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*WebsiteDomain, error) {
+	websiteDomainObj := &WebsiteDomain{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"website_domains\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, websiteDomainObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from website_domains")
+	}
+
+	if err = websiteDomainObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return websiteDomainObj, err
+	}
+
+	return websiteDomainObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebsiteDomain) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_domains provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteDomainColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	websiteDomainInsertCacheMut.RLock()
+	cache, cached := websiteDomainInsertCache[key]
+	websiteDomainInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			websiteDomainAllColumns,
+			websiteDomainColumnsWithDefault,
+			websiteDomainColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, websiteDomainGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(websiteDomainType, websiteDomainMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(websiteDomainType, websiteDomainMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"website_domains\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"website_domains\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into website_domains")
+	}
+
+	if !cached {
+		websiteDomainInsertCacheMut.Lock()
+		websiteDomainInsertCache[key] = cache
+		websiteDomainInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebsiteDomain.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebsiteDomain) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	websiteDomainUpdateCacheMut.RLock()
+	cache, cached := websiteDomainUpdateCache[key]
+	websiteDomainUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			websiteDomainAllColumns,
+			websiteDomainPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, websiteDomainGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update website_domains, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"website_domains\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, websiteDomainPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(websiteDomainType, websiteDomainMapping, append(wl, websiteDomainPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update website_domains row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for website_domains")
+	}
+
+	if !cached {
+		websiteDomainUpdateCacheMut.Lock()
+		websiteDomainUpdateCache[key] = cache
+		websiteDomainUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q websiteDomainQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for website_domains")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for website_domains")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebsiteDomainSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteDomainPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"website_domains\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteDomainPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in websiteDomain slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all websiteDomain")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebsiteDomain) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_domains provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteDomainColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	websiteDomainUpsertCacheMut.RLock()
+	cache, cached := websiteDomainUpsertCache[key]
+	websiteDomainUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			websiteDomainAllColumns,
+			websiteDomainColumnsWithDefault,
+			websiteDomainColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			websiteDomainAllColumns,
+			websiteDomainPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert website_domains, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(websiteDomainAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(websiteDomainPrimaryKeyColumns))
+			copy(conflict, websiteDomainPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"website_domains\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(websiteDomainType, websiteDomainMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(websiteDomainType, websiteDomainMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert website_domains")
+	}
+
+	if !cached {
+		websiteDomainUpsertCacheMut.Lock()
+		websiteDomainUpsertCache[key] = cache
+		websiteDomainUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebsiteDomain record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebsiteDomain) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebsiteDomain provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), websiteDomainPrimaryKeyMapping)
+	sql := "DELETE FROM \"website_domains\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from website_domains")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for website_domains")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q websiteDomainQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no websiteDomainQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from website_domains")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_domains")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebsiteDomainSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(websiteDomainBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteDomainPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"website_domains\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteDomainPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from websiteDomain slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_domains")
+	}
+
+	if len(websiteDomainAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebsiteDomain) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebsiteDomain(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebsiteDomainSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebsiteDomainSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteDomainPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"website_domains\".* FROM \"website_domains\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteDomainPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebsiteDomainSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebsiteDomainExists checks if the WebsiteDomain row exists.
+func WebsiteDomainExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"website_domains\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if website_domains exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebsiteDomain row exists.
+func (o *WebsiteDomain) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebsiteDomainExists(ctx, exec, o.ID)
+}
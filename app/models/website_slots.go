@@ -0,0 +1,1142 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WebsiteSlot is an object representing the database table.
+type WebsiteSlot struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	WebsiteID int64     `boil:"website_id" json:"website_id" toml:"website_id" yaml:"website_id"`
+	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *websiteSlotR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L websiteSlotL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebsiteSlotColumns = struct {
+	ID        string
+	WebsiteID string
+	Name      string
+	CreatedAt string
+}{
+	ID:        "id",
+	WebsiteID: "website_id",
+	Name:      "name",
+	CreatedAt: "created_at",
+}
+
+var WebsiteSlotTableColumns = struct {
+	ID        string
+	WebsiteID string
+	Name      string
+	CreatedAt string
+}{
+	ID:        "website_slots.id",
+	WebsiteID: "website_slots.website_id",
+	Name:      "website_slots.name",
+	CreatedAt: "website_slots.created_at",
+}
+
+// Generated where
+
+var WebsiteSlotWhere = struct {
+	ID        whereHelperint64
+	WebsiteID whereHelperint64
+	Name      whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint64{field: "\"website_slots\".\"id\""},
+	WebsiteID: whereHelperint64{field: "\"website_slots\".\"website_id\""},
+	Name:      whereHelperstring{field: "\"website_slots\".\"name\""},
+	CreatedAt: whereHelpertime_Time{field: "\"website_slots\".\"created_at\""},
+}
+
+// WebsiteSlotRels is where relationship names are stored.
+var WebsiteSlotRels = struct {
+	Website string
+}{
+	Website: "Website",
+}
+
+// websiteSlotR is where relationships are stored.
+type websiteSlotR struct {
+	Website *Website `boil:"Website" json:"Website" toml:"Website" yaml:"Website"`
+}
+
+// NewStruct creates a new relationship struct
+func (*websiteSlotR) NewStruct() *websiteSlotR {
+	return &websiteSlotR{}
+}
+
+func (r *websiteSlotR) GetWebsite() *Website {
+	if r == nil {
+		return nil
+	}
+	return r.Website
+}
+
+// websiteSlotL is where Load methods for each relationship are stored.
+type websiteSlotL struct{}
+
+var (
+	websiteSlotAllColumns            = []string{"id", "website_id", "name", "created_at"}
+	websiteSlotColumnsWithoutDefault = []string{"website_id", "name", "created_at"}
+	websiteSlotColumnsWithDefault    = []string{"id"}
+	websiteSlotPrimaryKeyColumns     = []string{"id"}
+	websiteSlotGeneratedColumns      = []string{"id"}
+)
+
+type (
+	// WebsiteSlotSlice is an alias for a slice of pointers to WebsiteSlot.
+	// This should almost always be used instead of []WebsiteSlot.
+	WebsiteSlotSlice []*WebsiteSlot
+	// WebsiteSlotHook is the signature for custom WebsiteSlot hook methods
+	WebsiteSlotHook func(context.Context, boil.ContextExecutor, *WebsiteSlot) error
+
+	websiteSlotQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	websiteSlotType                 = reflect.TypeOf(&WebsiteSlot{})
+	websiteSlotMapping              = queries.MakeStructMapping(websiteSlotType)
+	websiteSlotPrimaryKeyMapping, _ = queries.BindMapping(websiteSlotType, websiteSlotMapping, websiteSlotPrimaryKeyColumns)
+	websiteSlotInsertCacheMut       sync.RWMutex
+	websiteSlotInsertCache          = make(map[string]insertCache)
+	websiteSlotUpdateCacheMut       sync.RWMutex
+	websiteSlotUpdateCache          = make(map[string]updateCache)
+	websiteSlotUpsertCacheMut       sync.RWMutex
+	websiteSlotUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var websiteSlotAfterSelectMu sync.Mutex
+var websiteSlotAfterSelectHooks []WebsiteSlotHook
+
+var websiteSlotBeforeInsertMu sync.Mutex
+var websiteSlotBeforeInsertHooks []WebsiteSlotHook
+var websiteSlotAfterInsertMu sync.Mutex
+var websiteSlotAfterInsertHooks []WebsiteSlotHook
+
+var websiteSlotBeforeUpdateMu sync.Mutex
+var websiteSlotBeforeUpdateHooks []WebsiteSlotHook
+var websiteSlotAfterUpdateMu sync.Mutex
+var websiteSlotAfterUpdateHooks []WebsiteSlotHook
+
+var websiteSlotBeforeDeleteMu sync.Mutex
+var websiteSlotBeforeDeleteHooks []WebsiteSlotHook
+var websiteSlotAfterDeleteMu sync.Mutex
+var websiteSlotAfterDeleteHooks []WebsiteSlotHook
+
+var websiteSlotBeforeUpsertMu sync.Mutex
+var websiteSlotBeforeUpsertHooks []WebsiteSlotHook
+var websiteSlotAfterUpsertMu sync.Mutex
+var websiteSlotAfterUpsertHooks []WebsiteSlotHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebsiteSlot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteSlotAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebsiteSlot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteSlotBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebsiteSlot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteSlotAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebsiteSlot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteSlotBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebsiteSlot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteSlotAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebsiteSlot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteSlotBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebsiteSlot) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteSlotAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebsiteSlot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteSlotBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebsiteSlot) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range websiteSlotAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebsiteSlotHook registers your hook function for all future operations.
+func AddWebsiteSlotHook(hookPoint boil.HookPoint, websiteSlotHook WebsiteSlotHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		websiteSlotAfterSelectMu.Lock()
+		websiteSlotAfterSelectHooks = append(websiteSlotAfterSelectHooks, websiteSlotHook)
+		websiteSlotAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		websiteSlotBeforeInsertMu.Lock()
+		websiteSlotBeforeInsertHooks = append(websiteSlotBeforeInsertHooks, websiteSlotHook)
+		websiteSlotBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		websiteSlotAfterInsertMu.Lock()
+		websiteSlotAfterInsertHooks = append(websiteSlotAfterInsertHooks, websiteSlotHook)
+		websiteSlotAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		websiteSlotBeforeUpdateMu.Lock()
+		websiteSlotBeforeUpdateHooks = append(websiteSlotBeforeUpdateHooks, websiteSlotHook)
+		websiteSlotBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		websiteSlotAfterUpdateMu.Lock()
+		websiteSlotAfterUpdateHooks = append(websiteSlotAfterUpdateHooks, websiteSlotHook)
+		websiteSlotAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		websiteSlotBeforeDeleteMu.Lock()
+		websiteSlotBeforeDeleteHooks = append(websiteSlotBeforeDeleteHooks, websiteSlotHook)
+		websiteSlotBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		websiteSlotAfterDeleteMu.Lock()
+		websiteSlotAfterDeleteHooks = append(websiteSlotAfterDeleteHooks, websiteSlotHook)
+		websiteSlotAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		websiteSlotBeforeUpsertMu.Lock()
+		websiteSlotBeforeUpsertHooks = append(websiteSlotBeforeUpsertHooks, websiteSlotHook)
+		websiteSlotBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		websiteSlotAfterUpsertMu.Lock()
+		websiteSlotAfterUpsertHooks = append(websiteSlotAfterUpsertHooks, websiteSlotHook)
+		websiteSlotAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single websiteSlot record from the query.
+func (q websiteSlotQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebsiteSlot, error) {
+	o := &WebsiteSlot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for website_slots")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebsiteSlot records from the query.
+func (q websiteSlotQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebsiteSlotSlice, error) {
+	var o []*WebsiteSlot
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebsiteSlot slice")
+	}
+
+	if len(websiteSlotAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebsiteSlot records in the query.
+func (q websiteSlotQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count website_slots rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q websiteSlotQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if website_slots exists")
+	}
+
+	return count > 0, nil
+}
+
+// Website pointed to by the foreign key.
+func (o *WebsiteSlot) Website(mods ...qm.QueryMod) websiteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WebsiteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Websites(queryMods...)
+}
+
+// LoadWebsite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (websiteSlotL) LoadWebsite(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsiteSlot interface{}, mods queries.Applicator) error {
+	var slice []*WebsiteSlot
+	var object *WebsiteSlot
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsiteSlot.(*WebsiteSlot)
+		if !ok {
+			object = new(WebsiteSlot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsiteSlot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsiteSlot))
+			}
+		}
+	} else {
+		s, ok := maybeWebsiteSlot.(*[]*WebsiteSlot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsiteSlot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsiteSlot))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteSlotR{}
+		}
+		args[object.WebsiteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteSlotR{}
+			}
+
+			args[obj.WebsiteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites`),
+		qm.WhereIn(`websites.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Website")
+	}
+
+	var resultSlice []*Website
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Website")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for websites")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites")
+	}
+
+	if len(websiteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Website = foreign
+		if foreign.R == nil {
+			foreign.R = &websiteR{}
+		}
+		foreign.R.WebsiteSlots = append(foreign.R.WebsiteSlots, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebsiteID == foreign.ID {
+				local.R.Website = foreign
+				if foreign.R == nil {
+					foreign.R = &websiteR{}
+				}
+				foreign.R.WebsiteSlots = append(foreign.R.WebsiteSlots, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebsite of the websiteSlot to the related item.
+// Sets o.R.Website to related.
+// Adds o to related.R.WebsiteSlots.
+func (o *WebsiteSlot) SetWebsite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Website) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"website_slots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"website_id"}),
+		strmangle.WhereClause("\"", "\"", 0, websiteSlotPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebsiteID = related.ID
+	if o.R == nil {
+		o.R = &websiteSlotR{
+			Website: related,
+		}
+	} else {
+		o.R.Website = related
+	}
+
+	if related.R == nil {
+		related.R = &websiteR{
+			WebsiteSlots: WebsiteSlotSlice{o},
+		}
+	} else {
+		related.R.WebsiteSlots = append(related.R.WebsiteSlots, o)
+	}
+
+	return nil
+}
+
+// WebsiteSlots retrieves all the records using an executor.
+func WebsiteSlots(mods ...qm.QueryMod) websiteSlotQuery {
+	mods = append(mods, qm.From("\"website_slots\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"website_slots\".*"})
+	}
+
+	return websiteSlotQuery{q}
+}
+
+// FindWebsiteSlot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebsiteSlot(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*WebsiteSlot, error) {
+	websiteSlotObj := &WebsiteSlot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"website_slots\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, websiteSlotObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from website_slots")
+	}
+
+	if err = websiteSlotObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return websiteSlotObj, err
+	}
+
+	return websiteSlotObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebsiteSlot) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_slots provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteSlotColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	websiteSlotInsertCacheMut.RLock()
+	cache, cached := websiteSlotInsertCache[key]
+	websiteSlotInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			websiteSlotAllColumns,
+			websiteSlotColumnsWithDefault,
+			websiteSlotColumnsWithoutDefault,
+			nzDefaults,
+		)
+		wl = strmangle.SetComplement(wl, websiteSlotGeneratedColumns)
+
+		cache.valueMapping, err = queries.BindMapping(websiteSlotType, websiteSlotMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(websiteSlotType, websiteSlotMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"website_slots\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"website_slots\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into website_slots")
+	}
+
+	if !cached {
+		websiteSlotInsertCacheMut.Lock()
+		websiteSlotInsertCache[key] = cache
+		websiteSlotInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebsiteSlot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebsiteSlot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	websiteSlotUpdateCacheMut.RLock()
+	cache, cached := websiteSlotUpdateCache[key]
+	websiteSlotUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			websiteSlotAllColumns,
+			websiteSlotPrimaryKeyColumns,
+		)
+		wl = strmangle.SetComplement(wl, websiteSlotGeneratedColumns)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update website_slots, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"website_slots\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, websiteSlotPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(websiteSlotType, websiteSlotMapping, append(wl, websiteSlotPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update website_slots row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for website_slots")
+	}
+
+	if !cached {
+		websiteSlotUpdateCacheMut.Lock()
+		websiteSlotUpdateCache[key] = cache
+		websiteSlotUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q websiteSlotQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for website_slots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for website_slots")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebsiteSlotSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteSlotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"website_slots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteSlotPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in websiteSlot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all websiteSlot")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebsiteSlot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no website_slots provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(websiteSlotColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	websiteSlotUpsertCacheMut.RLock()
+	cache, cached := websiteSlotUpsertCache[key]
+	websiteSlotUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			websiteSlotAllColumns,
+			websiteSlotColumnsWithDefault,
+			websiteSlotColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			websiteSlotAllColumns,
+			websiteSlotPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert website_slots, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(websiteSlotAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(websiteSlotPrimaryKeyColumns))
+			copy(conflict, websiteSlotPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQuerySQLite(dialect, "\"website_slots\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(websiteSlotType, websiteSlotMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(websiteSlotType, websiteSlotMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert website_slots")
+	}
+
+	if !cached {
+		websiteSlotUpsertCacheMut.Lock()
+		websiteSlotUpsertCache[key] = cache
+		websiteSlotUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebsiteSlot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebsiteSlot) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebsiteSlot provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), websiteSlotPrimaryKeyMapping)
+	sql := "DELETE FROM \"website_slots\" WHERE \"id\"=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from website_slots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for website_slots")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q websiteSlotQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no websiteSlotQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from website_slots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_slots")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebsiteSlotSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(websiteSlotBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteSlotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"website_slots\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteSlotPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from websiteSlot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for website_slots")
+	}
+
+	if len(websiteSlotAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebsiteSlot) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebsiteSlot(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebsiteSlotSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebsiteSlotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), websiteSlotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"website_slots\".* FROM \"website_slots\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, websiteSlotPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebsiteSlotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebsiteSlotExists checks if the WebsiteSlot row exists.
+func WebsiteSlotExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"website_slots\" where \"id\"=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if website_slots exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebsiteSlot row exists.
+func (o *WebsiteSlot) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebsiteSlotExists(ctx, exec, o.ID)
+}
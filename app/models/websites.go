@@ -23,72 +23,165 @@ import (
 
 // Website is an object representing the database table.
 type Website struct {
-	ID      int64  `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name    string `boil:"name" json:"name" toml:"name" yaml:"name"`
-	URL     string `boil:"url" json:"url" toml:"url" yaml:"url"`
-	Staging bool   `boil:"staging" json:"staging" toml:"staging" yaml:"staging"`
+	ID                int64  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name              string `boil:"name" json:"name" toml:"name" yaml:"name"`
+	URL               string `boil:"url" json:"url" toml:"url" yaml:"url"`
+	Staging           bool   `boil:"staging" json:"staging" toml:"staging" yaml:"staging"`
+	AllowOriginLookup bool   `boil:"allow_origin_lookup" json:"allow_origin_lookup" toml:"allow_origin_lookup" yaml:"allow_origin_lookup"`
+	FallbackLanguage  string `boil:"fallback_language" json:"fallback_language" toml:"fallback_language" yaml:"fallback_language"`
+	ForbidLinks       bool   `boil:"forbid_links" json:"forbid_links" toml:"forbid_links" yaml:"forbid_links"`
+	ForbidImages      bool   `boil:"forbid_images" json:"forbid_images" toml:"forbid_images" yaml:"forbid_images"`
+	AllowedURLSchemes string `boil:"allowed_url_schemes" json:"allowed_url_schemes" toml:"allowed_url_schemes" yaml:"allowed_url_schemes"`
+	CorsOrigins       string `boil:"cors_origins" json:"cors_origins" toml:"cors_origins" yaml:"cors_origins"`
+	CorsMaxAge        int64  `boil:"cors_max_age" json:"cors_max_age" toml:"cors_max_age" yaml:"cors_max_age"`
+	RateLimit         int64  `boil:"rate_limit" json:"rate_limit" toml:"rate_limit" yaml:"rate_limit"`
+	RateBurst         int64  `boil:"rate_burst" json:"rate_burst" toml:"rate_burst" yaml:"rate_burst"`
+	RejectedRequests  int64  `boil:"rejected_requests" json:"rejected_requests" toml:"rejected_requests" yaml:"rejected_requests"`
+	LinkTracking      bool   `boil:"link_tracking" json:"link_tracking" toml:"link_tracking" yaml:"link_tracking"`
+	Timezone          string `boil:"timezone" json:"timezone" toml:"timezone" yaml:"timezone"`
 
 	R *websiteR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L websiteL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var WebsiteColumns = struct {
-	ID      string
-	Name    string
-	URL     string
-	Staging string
+	ID                string
+	Name              string
+	URL               string
+	Staging           string
+	AllowOriginLookup string
+	FallbackLanguage  string
+	ForbidLinks       string
+	ForbidImages      string
+	AllowedURLSchemes string
+	CorsOrigins       string
+	CorsMaxAge        string
+	RateLimit         string
+	RateBurst         string
+	RejectedRequests  string
+	LinkTracking      string
+	Timezone          string
 }{
-	ID:      "id",
-	Name:    "name",
-	URL:     "url",
-	Staging: "staging",
+	ID:                "id",
+	Name:              "name",
+	URL:               "url",
+	Staging:           "staging",
+	AllowOriginLookup: "allow_origin_lookup",
+	FallbackLanguage:  "fallback_language",
+	ForbidLinks:       "forbid_links",
+	ForbidImages:      "forbid_images",
+	AllowedURLSchemes: "allowed_url_schemes",
+	CorsOrigins:       "cors_origins",
+	CorsMaxAge:        "cors_max_age",
+	RateLimit:         "rate_limit",
+	RateBurst:         "rate_burst",
+	RejectedRequests:  "rejected_requests",
+	LinkTracking:      "link_tracking",
+	Timezone:          "timezone",
 }
 
 var WebsiteTableColumns = struct {
-	ID      string
-	Name    string
-	URL     string
-	Staging string
+	ID                string
+	Name              string
+	URL               string
+	Staging           string
+	AllowOriginLookup string
+	FallbackLanguage  string
+	ForbidLinks       string
+	ForbidImages      string
+	AllowedURLSchemes string
+	CorsOrigins       string
+	CorsMaxAge        string
+	RateLimit         string
+	RateBurst         string
+	RejectedRequests  string
+	LinkTracking      string
+	Timezone          string
 }{
-	ID:      "websites.id",
-	Name:    "websites.name",
-	URL:     "websites.url",
-	Staging: "websites.staging",
+	ID:                "websites.id",
+	Name:              "websites.name",
+	URL:               "websites.url",
+	Staging:           "websites.staging",
+	AllowOriginLookup: "websites.allow_origin_lookup",
+	FallbackLanguage:  "websites.fallback_language",
+	ForbidLinks:       "websites.forbid_links",
+	ForbidImages:      "websites.forbid_images",
+	AllowedURLSchemes: "websites.allowed_url_schemes",
+	CorsOrigins:       "websites.cors_origins",
+	CorsMaxAge:        "websites.cors_max_age",
+	RateLimit:         "websites.rate_limit",
+	RateBurst:         "websites.rate_burst",
+	RejectedRequests:  "websites.rejected_requests",
+	LinkTracking:      "websites.link_tracking",
+	Timezone:          "websites.timezone",
 }
 
 // Generated where
 
-type whereHelperbool struct{ field string }
-
-func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-
 var WebsiteWhere = struct {
-	ID      whereHelperint64
-	Name    whereHelperstring
-	URL     whereHelperstring
-	Staging whereHelperbool
+	ID                whereHelperint64
+	Name              whereHelperstring
+	URL               whereHelperstring
+	Staging           whereHelperbool
+	AllowOriginLookup whereHelperbool
+	FallbackLanguage  whereHelperstring
+	ForbidLinks       whereHelperbool
+	ForbidImages      whereHelperbool
+	AllowedURLSchemes whereHelperstring
+	CorsOrigins       whereHelperstring
+	CorsMaxAge        whereHelperint64
+	RateLimit         whereHelperint64
+	RateBurst         whereHelperint64
+	RejectedRequests  whereHelperint64
+	LinkTracking      whereHelperbool
+	Timezone          whereHelperstring
 }{
-	ID:      whereHelperint64{field: "\"websites\".\"id\""},
-	Name:    whereHelperstring{field: "\"websites\".\"name\""},
-	URL:     whereHelperstring{field: "\"websites\".\"url\""},
-	Staging: whereHelperbool{field: "\"websites\".\"staging\""},
+	ID:                whereHelperint64{field: "\"websites\".\"id\""},
+	Name:              whereHelperstring{field: "\"websites\".\"name\""},
+	URL:               whereHelperstring{field: "\"websites\".\"url\""},
+	Staging:           whereHelperbool{field: "\"websites\".\"staging\""},
+	AllowOriginLookup: whereHelperbool{field: "\"websites\".\"allow_origin_lookup\""},
+	FallbackLanguage:  whereHelperstring{field: "\"websites\".\"fallback_language\""},
+	ForbidLinks:       whereHelperbool{field: "\"websites\".\"forbid_links\""},
+	ForbidImages:      whereHelperbool{field: "\"websites\".\"forbid_images\""},
+	AllowedURLSchemes: whereHelperstring{field: "\"websites\".\"allowed_url_schemes\""},
+	CorsOrigins:       whereHelperstring{field: "\"websites\".\"cors_origins\""},
+	CorsMaxAge:        whereHelperint64{field: "\"websites\".\"cors_max_age\""},
+	RateLimit:         whereHelperint64{field: "\"websites\".\"rate_limit\""},
+	RateBurst:         whereHelperint64{field: "\"websites\".\"rate_burst\""},
+	RejectedRequests:  whereHelperint64{field: "\"websites\".\"rejected_requests\""},
+	LinkTracking:      whereHelperbool{field: "\"websites\".\"link_tracking\""},
+	Timezone:          whereHelperstring{field: "\"websites\".\"timezone\""},
 }
 
 // WebsiteRels is where relationship names are stored.
 var WebsiteRels = struct {
+	MessageLinkClicks         string
+	MessageStats              string
+	PreviewTokens             string
+	WebsiteAPIKeys            string
+	WebsiteDomains            string
+	WebsiteSlots              string
 	WebsiteIdWebsitesMessages string
 }{
+	MessageLinkClicks:         "MessageLinkClicks",
+	MessageStats:              "MessageStats",
+	PreviewTokens:             "PreviewTokens",
+	WebsiteAPIKeys:            "WebsiteAPIKeys",
+	WebsiteDomains:            "WebsiteDomains",
+	WebsiteSlots:              "WebsiteSlots",
 	WebsiteIdWebsitesMessages: "WebsiteIdWebsitesMessages",
 }
 
 // websiteR is where relationships are stored.
 type websiteR struct {
-	WebsiteIdWebsitesMessages WebsitesMessageSlice `boil:"WebsiteIdWebsitesMessages" json:"WebsiteIdWebsitesMessages" toml:"WebsiteIdWebsitesMessages" yaml:"WebsiteIdWebsitesMessages"`
+	MessageLinkClicks         MessageLinkClickSlice `boil:"MessageLinkClicks" json:"MessageLinkClicks" toml:"MessageLinkClicks" yaml:"MessageLinkClicks"`
+	MessageStats              MessageStatSlice      `boil:"MessageStats" json:"MessageStats" toml:"MessageStats" yaml:"MessageStats"`
+	PreviewTokens             PreviewTokenSlice     `boil:"PreviewTokens" json:"PreviewTokens" toml:"PreviewTokens" yaml:"PreviewTokens"`
+	WebsiteAPIKeys            WebsiteAPIKeySlice    `boil:"WebsiteAPIKeys" json:"WebsiteAPIKeys" toml:"WebsiteAPIKeys" yaml:"WebsiteAPIKeys"`
+	WebsiteDomains            WebsiteDomainSlice    `boil:"WebsiteDomains" json:"WebsiteDomains" toml:"WebsiteDomains" yaml:"WebsiteDomains"`
+	WebsiteSlots              WebsiteSlotSlice      `boil:"WebsiteSlots" json:"WebsiteSlots" toml:"WebsiteSlots" yaml:"WebsiteSlots"`
+	WebsiteIdWebsitesMessages WebsitesMessageSlice  `boil:"WebsiteIdWebsitesMessages" json:"WebsiteIdWebsitesMessages" toml:"WebsiteIdWebsitesMessages" yaml:"WebsiteIdWebsitesMessages"`
 }
 
 // NewStruct creates a new relationship struct
@@ -96,6 +189,48 @@ func (*websiteR) NewStruct() *websiteR {
 	return &websiteR{}
 }
 
+func (r *websiteR) GetMessageLinkClicks() MessageLinkClickSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MessageLinkClicks
+}
+
+func (r *websiteR) GetMessageStats() MessageStatSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MessageStats
+}
+
+func (r *websiteR) GetPreviewTokens() PreviewTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PreviewTokens
+}
+
+func (r *websiteR) GetWebsiteAPIKeys() WebsiteAPIKeySlice {
+	if r == nil {
+		return nil
+	}
+	return r.WebsiteAPIKeys
+}
+
+func (r *websiteR) GetWebsiteDomains() WebsiteDomainSlice {
+	if r == nil {
+		return nil
+	}
+	return r.WebsiteDomains
+}
+
+func (r *websiteR) GetWebsiteSlots() WebsiteSlotSlice {
+	if r == nil {
+		return nil
+	}
+	return r.WebsiteSlots
+}
+
 func (r *websiteR) GetWebsiteIdWebsitesMessages() WebsitesMessageSlice {
 	if r == nil {
 		return nil
@@ -107,9 +242,9 @@ func (r *websiteR) GetWebsiteIdWebsitesMessages() WebsitesMessageSlice {
 type websiteL struct{}
 
 var (
-	websiteAllColumns            = []string{"id", "name", "url", "staging"}
+	websiteAllColumns            = []string{"id", "name", "url", "staging", "allow_origin_lookup", "fallback_language", "forbid_links", "forbid_images", "allowed_url_schemes", "cors_origins", "cors_max_age", "rate_limit", "rate_burst", "rejected_requests", "link_tracking", "timezone"}
 	websiteColumnsWithoutDefault = []string{"name", "url"}
-	websiteColumnsWithDefault    = []string{"id", "staging"}
+	websiteColumnsWithDefault    = []string{"id", "staging", "allow_origin_lookup", "fallback_language", "forbid_links", "forbid_images", "allowed_url_schemes", "cors_origins", "cors_max_age", "rate_limit", "rate_burst", "rejected_requests", "link_tracking", "timezone"}
 	websitePrimaryKeyColumns     = []string{"id"}
 	websiteGeneratedColumns      = []string{"id"}
 )
@@ -419,6 +554,90 @@ func (q websiteQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bo
 	return count > 0, nil
 }
 
+// MessageLinkClicks retrieves all the message_link_click's MessageLinkClicks with an executor.
+func (o *Website) MessageLinkClicks(mods ...qm.QueryMod) messageLinkClickQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"message_link_clicks\".\"website_id\"=?", o.ID),
+	)
+
+	return MessageLinkClicks(queryMods...)
+}
+
+// MessageStats retrieves all the message_stat's MessageStats with an executor.
+func (o *Website) MessageStats(mods ...qm.QueryMod) messageStatQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"message_stats\".\"website_id\"=?", o.ID),
+	)
+
+	return MessageStats(queryMods...)
+}
+
+// PreviewTokens retrieves all the preview_token's PreviewTokens with an executor.
+func (o *Website) PreviewTokens(mods ...qm.QueryMod) previewTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"preview_tokens\".\"website_id\"=?", o.ID),
+	)
+
+	return PreviewTokens(queryMods...)
+}
+
+// WebsiteAPIKeys retrieves all the website_api_key's WebsiteAPIKeys with an executor.
+func (o *Website) WebsiteAPIKeys(mods ...qm.QueryMod) websiteAPIKeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"website_api_keys\".\"website_id\"=?", o.ID),
+	)
+
+	return WebsiteAPIKeys(queryMods...)
+}
+
+// WebsiteDomains retrieves all the website_domain's WebsiteDomains with an executor.
+func (o *Website) WebsiteDomains(mods ...qm.QueryMod) websiteDomainQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"website_domains\".\"website_id\"=?", o.ID),
+	)
+
+	return WebsiteDomains(queryMods...)
+}
+
+// WebsiteSlots retrieves all the website_slot's WebsiteSlots with an executor.
+func (o *Website) WebsiteSlots(mods ...qm.QueryMod) websiteSlotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"website_slots\".\"website_id\"=?", o.ID),
+	)
+
+	return WebsiteSlots(queryMods...)
+}
+
 // WebsiteIdWebsitesMessages retrieves all the websites_message's WebsitesMessages with an executor via websiteId column.
 func (o *Website) WebsiteIdWebsitesMessages(mods ...qm.QueryMod) websitesMessageQuery {
 	var queryMods []qm.QueryMod
@@ -433,9 +652,9 @@ func (o *Website) WebsiteIdWebsitesMessages(mods ...qm.QueryMod) websitesMessage
 	return WebsitesMessages(queryMods...)
 }
 
-// LoadWebsiteIdWebsitesMessages allows an eager lookup of values, cached into the
+// LoadMessageLinkClicks allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (websiteL) LoadWebsiteIdWebsitesMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite interface{}, mods queries.Applicator) error {
+func (websiteL) LoadMessageLinkClicks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite interface{}, mods queries.Applicator) error {
 	var slice []*Website
 	var object *Website
 
@@ -488,8 +707,8 @@ func (websiteL) LoadWebsiteIdWebsitesMessages(ctx context.Context, e boil.Contex
 	}
 
 	query := NewQuery(
-		qm.From(`websites_messages`),
-		qm.WhereIn(`websites_messages.websiteId in ?`, argsSlice...),
+		qm.From(`message_link_clicks`),
+		qm.WhereIn(`message_link_clicks.website_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -497,22 +716,22 @@ func (websiteL) LoadWebsiteIdWebsitesMessages(ctx context.Context, e boil.Contex
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load websites_messages")
+		return errors.Wrap(err, "failed to eager load message_link_clicks")
 	}
 
-	var resultSlice []*WebsitesMessage
+	var resultSlice []*MessageLinkClick
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice websites_messages")
+		return errors.Wrap(err, "failed to bind eager loaded slice message_link_clicks")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on websites_messages")
+		return errors.Wrap(err, "failed to close results in eager load on message_link_clicks")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites_messages")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for message_link_clicks")
 	}
 
-	if len(websitesMessageAfterSelectHooks) != 0 {
+	if len(messageLinkClickAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -520,24 +739,137 @@ func (websiteL) LoadWebsiteIdWebsitesMessages(ctx context.Context, e boil.Contex
 		}
 	}
 	if singular {
-		object.R.WebsiteIdWebsitesMessages = resultSlice
+		object.R.MessageLinkClicks = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &websitesMessageR{}
+				foreign.R = &messageLinkClickR{}
 			}
-			foreign.R.WebsiteIdWebsite = object
+			foreign.R.Website = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.WebsiteId {
-				local.R.WebsiteIdWebsitesMessages = append(local.R.WebsiteIdWebsitesMessages, foreign)
+			if local.ID == foreign.WebsiteID {
+				local.R.MessageLinkClicks = append(local.R.MessageLinkClicks, foreign)
 				if foreign.R == nil {
-					foreign.R = &websitesMessageR{}
+					foreign.R = &messageLinkClickR{}
 				}
-				foreign.R.WebsiteIdWebsite = local
+				foreign.R.Website = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMessageStats allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (websiteL) LoadMessageStats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite interface{}, mods queries.Applicator) error {
+	var slice []*Website
+	var object *Website
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsite.(*Website)
+		if !ok {
+			object = new(Website)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsite))
+			}
+		}
+	} else {
+		s, ok := maybeWebsite.(*[]*Website)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsite))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`message_stats`),
+		qm.WhereIn(`message_stats.website_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load message_stats")
+	}
+
+	var resultSlice []*MessageStat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice message_stats")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on message_stats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for message_stats")
+	}
+
+	if len(messageStatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MessageStats = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &messageStatR{}
+			}
+			foreign.R.Website = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WebsiteID {
+				local.R.MessageStats = append(local.R.MessageStats, foreign)
+				if foreign.R == nil {
+					foreign.R = &messageStatR{}
+				}
+				foreign.R.Website = local
 				break
 			}
 		}
@@ -546,6 +878,963 @@ func (websiteL) LoadWebsiteIdWebsitesMessages(ctx context.Context, e boil.Contex
 	return nil
 }
 
+// LoadPreviewTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (websiteL) LoadPreviewTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite interface{}, mods queries.Applicator) error {
+	var slice []*Website
+	var object *Website
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsite.(*Website)
+		if !ok {
+			object = new(Website)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsite))
+			}
+		}
+	} else {
+		s, ok := maybeWebsite.(*[]*Website)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsite))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`preview_tokens`),
+		qm.WhereIn(`preview_tokens.website_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load preview_tokens")
+	}
+
+	var resultSlice []*PreviewToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice preview_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on preview_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for preview_tokens")
+	}
+
+	if len(previewTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PreviewTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &previewTokenR{}
+			}
+			foreign.R.Website = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.WebsiteID) {
+				local.R.PreviewTokens = append(local.R.PreviewTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &previewTokenR{}
+				}
+				foreign.R.Website = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebsiteAPIKeys allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (websiteL) LoadWebsiteAPIKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite interface{}, mods queries.Applicator) error {
+	var slice []*Website
+	var object *Website
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsite.(*Website)
+		if !ok {
+			object = new(Website)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsite))
+			}
+		}
+	} else {
+		s, ok := maybeWebsite.(*[]*Website)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsite))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`website_api_keys`),
+		qm.WhereIn(`website_api_keys.website_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load website_api_keys")
+	}
+
+	var resultSlice []*WebsiteAPIKey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice website_api_keys")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on website_api_keys")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for website_api_keys")
+	}
+
+	if len(websiteAPIKeyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebsiteAPIKeys = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &websiteAPIKeyR{}
+			}
+			foreign.R.Website = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WebsiteID {
+				local.R.WebsiteAPIKeys = append(local.R.WebsiteAPIKeys, foreign)
+				if foreign.R == nil {
+					foreign.R = &websiteAPIKeyR{}
+				}
+				foreign.R.Website = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebsiteDomains allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (websiteL) LoadWebsiteDomains(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite interface{}, mods queries.Applicator) error {
+	var slice []*Website
+	var object *Website
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsite.(*Website)
+		if !ok {
+			object = new(Website)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsite))
+			}
+		}
+	} else {
+		s, ok := maybeWebsite.(*[]*Website)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsite))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`website_domains`),
+		qm.WhereIn(`website_domains.website_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load website_domains")
+	}
+
+	var resultSlice []*WebsiteDomain
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice website_domains")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on website_domains")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for website_domains")
+	}
+
+	if len(websiteDomainAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebsiteDomains = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &websiteDomainR{}
+			}
+			foreign.R.Website = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WebsiteID {
+				local.R.WebsiteDomains = append(local.R.WebsiteDomains, foreign)
+				if foreign.R == nil {
+					foreign.R = &websiteDomainR{}
+				}
+				foreign.R.Website = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebsiteSlots allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (websiteL) LoadWebsiteSlots(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite interface{}, mods queries.Applicator) error {
+	var slice []*Website
+	var object *Website
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsite.(*Website)
+		if !ok {
+			object = new(Website)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsite))
+			}
+		}
+	} else {
+		s, ok := maybeWebsite.(*[]*Website)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsite))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`website_slots`),
+		qm.WhereIn(`website_slots.website_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load website_slots")
+	}
+
+	var resultSlice []*WebsiteSlot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice website_slots")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on website_slots")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for website_slots")
+	}
+
+	if len(websiteSlotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebsiteSlots = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &websiteSlotR{}
+			}
+			foreign.R.Website = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WebsiteID {
+				local.R.WebsiteSlots = append(local.R.WebsiteSlots, foreign)
+				if foreign.R == nil {
+					foreign.R = &websiteSlotR{}
+				}
+				foreign.R.Website = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebsiteIdWebsitesMessages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (websiteL) LoadWebsiteIdWebsitesMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebsite interface{}, mods queries.Applicator) error {
+	var slice []*Website
+	var object *Website
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebsite.(*Website)
+		if !ok {
+			object = new(Website)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebsite))
+			}
+		}
+	} else {
+		s, ok := maybeWebsite.(*[]*Website)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebsite)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebsite))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &websiteR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &websiteR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`websites_messages`),
+		qm.WhereIn(`websites_messages.websiteId in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load websites_messages")
+	}
+
+	var resultSlice []*WebsitesMessage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice websites_messages")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on websites_messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for websites_messages")
+	}
+
+	if len(websitesMessageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebsiteIdWebsitesMessages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &websitesMessageR{}
+			}
+			foreign.R.WebsiteIdWebsite = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WebsiteId {
+				local.R.WebsiteIdWebsitesMessages = append(local.R.WebsiteIdWebsitesMessages, foreign)
+				if foreign.R == nil {
+					foreign.R = &websitesMessageR{}
+				}
+				foreign.R.WebsiteIdWebsite = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddMessageLinkClicks adds the given related objects to the existing relationships
+// of the website, optionally inserting them as new records.
+// Appends related to o.R.MessageLinkClicks.
+// Sets related.R.Website appropriately.
+func (o *Website) AddMessageLinkClicks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MessageLinkClick) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WebsiteID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"message_link_clicks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"website_id"}),
+				strmangle.WhereClause("\"", "\"", 0, messageLinkClickPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WebsiteID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &websiteR{
+			MessageLinkClicks: related,
+		}
+	} else {
+		o.R.MessageLinkClicks = append(o.R.MessageLinkClicks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &messageLinkClickR{
+				Website: o,
+			}
+		} else {
+			rel.R.Website = o
+		}
+	}
+	return nil
+}
+
+// AddMessageStats adds the given related objects to the existing relationships
+// of the website, optionally inserting them as new records.
+// Appends related to o.R.MessageStats.
+// Sets related.R.Website appropriately.
+func (o *Website) AddMessageStats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MessageStat) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WebsiteID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"message_stats\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"website_id"}),
+				strmangle.WhereClause("\"", "\"", 0, messageStatPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WebsiteID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &websiteR{
+			MessageStats: related,
+		}
+	} else {
+		o.R.MessageStats = append(o.R.MessageStats, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &messageStatR{
+				Website: o,
+			}
+		} else {
+			rel.R.Website = o
+		}
+	}
+	return nil
+}
+
+// AddPreviewTokens adds the given related objects to the existing relationships
+// of the website, optionally inserting them as new records.
+// Appends related to o.R.PreviewTokens.
+// Sets related.R.Website appropriately.
+func (o *Website) AddPreviewTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PreviewToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.WebsiteID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"preview_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"website_id"}),
+				strmangle.WhereClause("\"", "\"", 0, previewTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.WebsiteID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &websiteR{
+			PreviewTokens: related,
+		}
+	} else {
+		o.R.PreviewTokens = append(o.R.PreviewTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &previewTokenR{
+				Website: o,
+			}
+		} else {
+			rel.R.Website = o
+		}
+	}
+	return nil
+}
+
+// SetPreviewTokens removes all previously related items of the
+// website replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Website's PreviewTokens accordingly.
+// Replaces o.R.PreviewTokens with related.
+// Sets related.R.Website's PreviewTokens accordingly.
+func (o *Website) SetPreviewTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PreviewToken) error {
+	query := "update \"preview_tokens\" set \"website_id\" = null where \"website_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.PreviewTokens {
+			queries.SetScanner(&rel.WebsiteID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Website = nil
+		}
+		o.R.PreviewTokens = nil
+	}
+
+	return o.AddPreviewTokens(ctx, exec, insert, related...)
+}
+
+// RemovePreviewTokens relationships from objects passed in.
+// Removes related items from R.PreviewTokens (uses pointer comparison, removal does not keep order)
+// Sets related.R.Website.
+func (o *Website) RemovePreviewTokens(ctx context.Context, exec boil.ContextExecutor, related ...*PreviewToken) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.WebsiteID, nil)
+		if rel.R != nil {
+			rel.R.Website = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("website_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.PreviewTokens {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.PreviewTokens)
+			if ln > 1 && i < ln-1 {
+				o.R.PreviewTokens[i] = o.R.PreviewTokens[ln-1]
+			}
+			o.R.PreviewTokens = o.R.PreviewTokens[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddWebsiteAPIKeys adds the given related objects to the existing relationships
+// of the website, optionally inserting them as new records.
+// Appends related to o.R.WebsiteAPIKeys.
+// Sets related.R.Website appropriately.
+func (o *Website) AddWebsiteAPIKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebsiteAPIKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WebsiteID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"website_api_keys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"website_id"}),
+				strmangle.WhereClause("\"", "\"", 0, websiteAPIKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WebsiteID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &websiteR{
+			WebsiteAPIKeys: related,
+		}
+	} else {
+		o.R.WebsiteAPIKeys = append(o.R.WebsiteAPIKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &websiteAPIKeyR{
+				Website: o,
+			}
+		} else {
+			rel.R.Website = o
+		}
+	}
+	return nil
+}
+
+// AddWebsiteDomains adds the given related objects to the existing relationships
+// of the website, optionally inserting them as new records.
+// Appends related to o.R.WebsiteDomains.
+// Sets related.R.Website appropriately.
+func (o *Website) AddWebsiteDomains(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebsiteDomain) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WebsiteID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"website_domains\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"website_id"}),
+				strmangle.WhereClause("\"", "\"", 0, websiteDomainPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WebsiteID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &websiteR{
+			WebsiteDomains: related,
+		}
+	} else {
+		o.R.WebsiteDomains = append(o.R.WebsiteDomains, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &websiteDomainR{
+				Website: o,
+			}
+		} else {
+			rel.R.Website = o
+		}
+	}
+	return nil
+}
+
+// AddWebsiteSlots adds the given related objects to the existing relationships
+// of the website, optionally inserting them as new records.
+// Appends related to o.R.WebsiteSlots.
+// Sets related.R.Website appropriately.
+func (o *Website) AddWebsiteSlots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebsiteSlot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WebsiteID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"website_slots\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"website_id"}),
+				strmangle.WhereClause("\"", "\"", 0, websiteSlotPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WebsiteID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &websiteR{
+			WebsiteSlots: related,
+		}
+	} else {
+		o.R.WebsiteSlots = append(o.R.WebsiteSlots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &websiteSlotR{
+				Website: o,
+			}
+		} else {
+			rel.R.Website = o
+		}
+	}
+	return nil
+}
+
 // AddWebsiteIdWebsitesMessages adds the given related objects to the existing relationships
 // of the website, optionally inserting them as new records.
 // Appends related to o.R.WebsiteIdWebsitesMessages.
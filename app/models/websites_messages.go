@@ -23,44 +23,65 @@ import (
 
 // WebsitesMessage is an object representing the database table.
 type WebsitesMessage struct {
-	ID        int64 `boil:"id" json:"id" toml:"id" yaml:"id"`
-	WebsiteId int64 `boil:"websiteId" json:"websiteId" toml:"websiteId" yaml:"websiteId"`
-	MessageId int64 `boil:"messageId" json:"messageId" toml:"messageId" yaml:"messageId"`
+	ID           int64  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	WebsiteId    int64  `boil:"websiteId" json:"websiteId" toml:"websiteId" yaml:"websiteId"`
+	MessageId    int64  `boil:"messageId" json:"messageId" toml:"messageId" yaml:"messageId"`
+	IncludePaths string `boil:"include_paths" json:"include_paths" toml:"include_paths" yaml:"include_paths"`
+	ExcludePaths string `boil:"exclude_paths" json:"exclude_paths" toml:"exclude_paths" yaml:"exclude_paths"`
+	Slot         string `boil:"slot" json:"slot" toml:"slot" yaml:"slot"`
 
 	R *websitesMessageR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L websitesMessageL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var WebsitesMessageColumns = struct {
-	ID        string
-	WebsiteId string
-	MessageId string
+	ID           string
+	WebsiteId    string
+	MessageId    string
+	IncludePaths string
+	ExcludePaths string
+	Slot         string
 }{
-	ID:        "id",
-	WebsiteId: "websiteId",
-	MessageId: "messageId",
+	ID:           "id",
+	WebsiteId:    "websiteId",
+	MessageId:    "messageId",
+	IncludePaths: "include_paths",
+	ExcludePaths: "exclude_paths",
+	Slot:         "slot",
 }
 
 var WebsitesMessageTableColumns = struct {
-	ID        string
-	WebsiteId string
-	MessageId string
+	ID           string
+	WebsiteId    string
+	MessageId    string
+	IncludePaths string
+	ExcludePaths string
+	Slot         string
 }{
-	ID:        "websites_messages.id",
-	WebsiteId: "websites_messages.websiteId",
-	MessageId: "websites_messages.messageId",
+	ID:           "websites_messages.id",
+	WebsiteId:    "websites_messages.websiteId",
+	MessageId:    "websites_messages.messageId",
+	IncludePaths: "websites_messages.include_paths",
+	ExcludePaths: "websites_messages.exclude_paths",
+	Slot:         "websites_messages.slot",
 }
 
 // Generated where
 
 var WebsitesMessageWhere = struct {
-	ID        whereHelperint64
-	WebsiteId whereHelperint64
-	MessageId whereHelperint64
+	ID           whereHelperint64
+	WebsiteId    whereHelperint64
+	MessageId    whereHelperint64
+	IncludePaths whereHelperstring
+	ExcludePaths whereHelperstring
+	Slot         whereHelperstring
 }{
-	ID:        whereHelperint64{field: "\"websites_messages\".\"id\""},
-	WebsiteId: whereHelperint64{field: "\"websites_messages\".\"websiteId\""},
-	MessageId: whereHelperint64{field: "\"websites_messages\".\"messageId\""},
+	ID:           whereHelperint64{field: "\"websites_messages\".\"id\""},
+	WebsiteId:    whereHelperint64{field: "\"websites_messages\".\"websiteId\""},
+	MessageId:    whereHelperint64{field: "\"websites_messages\".\"messageId\""},
+	IncludePaths: whereHelperstring{field: "\"websites_messages\".\"include_paths\""},
+	ExcludePaths: whereHelperstring{field: "\"websites_messages\".\"exclude_paths\""},
+	Slot:         whereHelperstring{field: "\"websites_messages\".\"slot\""},
 }
 
 // WebsitesMessageRels is where relationship names are stored.
@@ -101,9 +122,9 @@ func (r *websitesMessageR) GetWebsiteIdWebsite() *Website {
 type websitesMessageL struct{}
 
 var (
-	websitesMessageAllColumns            = []string{"id", "websiteId", "messageId"}
+	websitesMessageAllColumns            = []string{"id", "websiteId", "messageId", "include_paths", "exclude_paths", "slot"}
 	websitesMessageColumnsWithoutDefault = []string{"websiteId", "messageId"}
-	websitesMessageColumnsWithDefault    = []string{"id"}
+	websitesMessageColumnsWithDefault    = []string{"id", "include_paths", "exclude_paths", "slot"}
 	websitesMessagePrimaryKeyColumns     = []string{"id"}
 	websitesMessageGeneratedColumns      = []string{"id"}
 )
@@ -0,0 +1,171 @@
+// Package ratelimit limits the requests of the clients of the public API with
+// token buckets.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// sweepInterval is how often the idle buckets are dropped.
+const sweepInterval = time.Minute
+
+// Limits are the sustained rate and the burst allowed to a client. An
+// infinite rate disables the limit.
+type Limits struct {
+	Rate  rate.Limit
+	Burst int
+}
+
+// key identifies a bucket: the IP address of a client and the website its
+// credential resolved to, zero until it does.
+type key struct {
+	websiteId int64
+	ip        string
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter holds a token bucket per client IP address, charged with the
+// requests whose credential resolves to no website, and a bucket per website
+// and client IP address. Buckets are never keyed by the credential sent by a
+// client, so changing it neither resets its limits nor creates buckets.
+type Limiter struct {
+	mu        sync.Mutex
+	idle      time.Duration
+	buckets   map[key]*bucket
+	lastSweep time.Time
+	// rejected counts the rejected requests per website not yet taken by
+	// TakeRejected.
+	rejected map[int64]int64
+}
+
+// New returns a limiter dropping the buckets unused for idle, by which time
+// they are refilled.
+func New(idle time.Duration) *Limiter {
+	return &Limiter{
+		idle:     idle,
+		buckets:  make(map[key]*bucket),
+		rejected: make(map[int64]int64),
+	}
+}
+
+// Check returns how long the client at ip must wait before its next request,
+// zero when it may send one. It consumes no token, so it can run before the
+// credential of a request is looked up.
+func (l *Limiter) Check(ip string, now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key{ip: ip}]
+	if !ok {
+		return 0
+	}
+	if tokens := b.limiter.TokensAt(now); tokens < 1 {
+		return delay(1-tokens, b.limiter.Limit())
+	}
+	return 0
+}
+
+// TakeUnknown consumes a token from the bucket of the client at ip, for a
+// request whose credential resolves to no website. A positive duration means
+// the request is rejected, and is how long the client must wait.
+func (l *Limiter) TakeUnknown(ip string, limits Limits, now time.Time) time.Duration {
+	return l.take(key{ip: ip}, limits, now)
+}
+
+// Take consumes a token from the bucket of the client at ip on a website,
+// created with limits. A positive duration means the request is rejected, and
+// is how long the client must wait.
+func (l *Limiter) Take(websiteId int64, ip string, limits Limits, now time.Time) time.Duration {
+	return l.take(key{websiteId: websiteId, ip: ip}, limits, now)
+}
+
+func (l *Limiter) take(k key, limits Limits, now time.Time) time.Duration {
+	if limits.Rate == rate.Inf {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[k]
+	if !ok {
+		l.sweep(now)
+		b = &bucket{limiter: rate.NewLimiter(limits.Rate, max(limits.Burst, 1))}
+		l.buckets[k] = b
+	}
+	b.lastSeen = now
+
+	reservation := b.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return time.Minute
+	}
+	if wait := reservation.DelayFrom(now); wait > 0 {
+		reservation.CancelAt(now)
+		if k.websiteId != 0 {
+			l.rejected[k.websiteId]++
+		}
+		return wait
+	}
+	return 0
+}
+
+// sweep drops the buckets unused for a while. It runs at most once per
+// sweepInterval.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for k, b := range l.buckets {
+		if now.Sub(b.lastSeen) > l.idle {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+// Reset drops the buckets of a website, so its limits are read again.
+func (l *Limiter) Reset(websiteId int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for k := range l.buckets {
+		if k.websiteId == websiteId {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+// Rejected returns the rejected requests of a website not yet taken by
+// TakeRejected.
+func (l *Limiter) Rejected(websiteId int64) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rejected[websiteId]
+}
+
+// TakeRejected returns the rejected requests per website and resets their
+// counts.
+func (l *Limiter) TakeRejected() map[int64]int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rejected := l.rejected
+	l.rejected = make(map[int64]int64)
+	return rejected
+}
+
+// delay returns the time needed to refill tokens at limit.
+func delay(tokens float64, limit rate.Limit) time.Duration {
+	if limit <= 0 {
+		return time.Minute
+	}
+	return time.Duration(tokens / float64(limit) * float64(time.Second))
+}
@@ -0,0 +1,183 @@
+package ratelimit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// perMinute returns the limits of requests per minute and burst.
+func perMinute(requests float64, burst int) Limits {
+	return Limits{Rate: rate.Limit(requests / 60), Burst: burst}
+}
+
+var start = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func TestTakeBurstAndRefill(t *testing.T) {
+	l := New(10 * time.Minute)
+	limits := perMinute(60, 3)
+
+	for i := 0; i < 3; i++ {
+		if wait := l.Take(1, "192.0.2.1", limits, start); wait != 0 {
+			t.Fatalf("request %d of the burst rejected for %s", i+1, wait)
+		}
+	}
+	wait := l.Take(1, "192.0.2.1", limits, start)
+	if wait != time.Second {
+		t.Fatalf("the request after the burst should wait 1s, got %s", wait)
+	}
+	if rejected := l.Rejected(1); rejected != 1 {
+		t.Errorf("rejected = %d, want 1", rejected)
+	}
+
+	// A rejected request consumes no token.
+	if wait := l.Take(1, "192.0.2.1", limits, start.Add(500*time.Millisecond)); wait != 500*time.Millisecond {
+		t.Errorf("the request half a token later should wait 500ms, got %s", wait)
+	}
+	if wait := l.Take(1, "192.0.2.1", limits, start.Add(time.Second)); wait != 0 {
+		t.Errorf("a refilled token should be available, got %s", wait)
+	}
+	if wait := l.Take(1, "192.0.2.1", limits, start.Add(10*time.Second)); wait != 0 {
+		t.Errorf("the bucket should have refilled, got %s", wait)
+	}
+
+	if rejected := l.TakeRejected(); rejected[1] != 2 {
+		t.Errorf("TakeRejected = %v, want 2 for website 1", rejected)
+	}
+	if rejected := l.Rejected(1); rejected != 0 {
+		t.Errorf("rejected = %d after TakeRejected, want 0", rejected)
+	}
+}
+
+func TestTakePerWebsiteAndClient(t *testing.T) {
+	l := New(10 * time.Minute)
+	strict := perMinute(6, 1)
+	lenient := perMinute(600, 100)
+
+	if wait := l.Take(1, "192.0.2.1", strict, start); wait != 0 {
+		t.Fatalf("first request rejected for %s", wait)
+	}
+	if wait := l.Take(1, "192.0.2.1", strict, start); wait != 10*time.Second {
+		t.Errorf("Retry-After on the strict website = %s, want 10s", wait)
+	}
+	if wait := l.Take(2, "192.0.2.1", lenient, start); wait != 0 {
+		t.Errorf("the same client on another website should have its own bucket, got %s", wait)
+	}
+	if wait := l.Take(1, "192.0.2.2", strict, start); wait != 0 {
+		t.Errorf("another client on the same website should have its own bucket, got %s", wait)
+	}
+	if wait := l.Take(3, "192.0.2.1", Limits{Rate: rate.Inf}, start); wait != 0 {
+		t.Errorf("an infinite rate should never reject, got %s", wait)
+	}
+}
+
+// TestCredentialRotation checks that a client changing its API key or the
+// port of its Origin on every request gets neither a fresh bucket nor a new
+// one in memory.
+func TestCredentialRotation(t *testing.T) {
+	l := New(10 * time.Minute)
+	limits := perMinute(60, 5)
+
+	// Origins of the same website resolve to it, unknown API keys to none.
+	resolve := func(credential int) int64 {
+		if credential%2 == 0 {
+			return 1
+		}
+		return 0
+	}
+
+	rejected := 0
+	for credential := 0; credential < 40; credential++ {
+		if wait := l.Check("192.0.2.1", start); wait > 0 {
+			rejected++
+			continue
+		}
+		var wait time.Duration
+		if websiteId := resolve(credential); websiteId != 0 {
+			wait = l.Take(websiteId, "192.0.2.1", limits, start)
+		} else {
+			wait = l.TakeUnknown("192.0.2.1", limits, start)
+		}
+		if wait > 0 {
+			rejected++
+		}
+	}
+
+	if accepted := 40 - rejected; accepted != 10 {
+		t.Errorf("%d requests accepted, want the two bursts of 5", accepted)
+	}
+	if len(l.buckets) != 2 {
+		t.Errorf("%d buckets, want one per IP address and one for the website", len(l.buckets))
+	}
+}
+
+func TestCheck(t *testing.T) {
+	l := New(10 * time.Minute)
+	limits := perMinute(30, 2)
+
+	if wait := l.Check("192.0.2.1", start); wait != 0 {
+		t.Errorf("a client without bucket should pass, got %s", wait)
+	}
+	l.TakeUnknown("192.0.2.1", limits, start)
+	if wait := l.Check("192.0.2.1", start); wait != 0 {
+		t.Errorf("a client with tokens left should pass, got %s", wait)
+	}
+	l.TakeUnknown("192.0.2.1", limits, start)
+	if wait := l.Check("192.0.2.1", start); wait != 2*time.Second {
+		t.Errorf("an exhausted client should wait 2s, got %s", wait)
+	}
+	if wait := l.Check("192.0.2.1", start.Add(2*time.Second)); wait != 0 {
+		t.Errorf("a refilled client should pass, got %s", wait)
+	}
+
+	// Requests resolved to a website do not exhaust the bucket of the IP.
+	for i := 0; i < 5; i++ {
+		l.Take(1, "192.0.2.2", limits, start)
+	}
+	if wait := l.Check("192.0.2.2", start); wait != 0 {
+		t.Errorf("a client of a website should pass the check, got %s", wait)
+	}
+	if rejected := l.Rejected(0); rejected != 0 {
+		t.Errorf("requests without website should not be counted, got %d", rejected)
+	}
+}
+
+func TestReset(t *testing.T) {
+	l := New(10 * time.Minute)
+	limits := perMinute(60, 1)
+
+	for websiteId := int64(1); websiteId <= 3; websiteId++ {
+		for ip := 1; ip <= 2; ip++ {
+			l.Take(websiteId, fmt.Sprintf("192.0.2.%d", ip), limits, start)
+		}
+	}
+	l.TakeUnknown("192.0.2.1", limits, start)
+
+	l.Reset(2)
+	if len(l.buckets) != 5 {
+		t.Fatalf("%d buckets after Reset, want 5", len(l.buckets))
+	}
+	if wait := l.Take(2, "192.0.2.1", limits, start); wait != 0 {
+		t.Errorf("the bucket of the reset website should be full, got %s", wait)
+	}
+	if wait := l.Take(1, "192.0.2.1", limits, start); wait == 0 {
+		t.Error("the buckets of the other websites should be kept")
+	}
+	if wait := l.Check("192.0.2.1", start); wait == 0 {
+		t.Error("the buckets of the IP addresses should be kept")
+	}
+}
+
+func TestSweep(t *testing.T) {
+	l := New(10 * time.Minute)
+	limits := perMinute(60, 1)
+
+	l.Take(1, "192.0.2.1", limits, start)
+	l.Take(1, "192.0.2.2", limits, start.Add(8*time.Minute))
+	l.Take(1, "192.0.2.3", limits, start.Add(11*time.Minute))
+	if len(l.buckets) != 2 {
+		t.Errorf("%d buckets, want the idle one dropped", len(l.buckets))
+	}
+}
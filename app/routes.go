@@ -39,8 +39,18 @@ func InitializeRoutes(router *chi.Mux) {
 		app.Use(kit.WithAuthentication(authConfig, false)) // strict set to false
 		router.Get("/set-language", HandleSetLanguage)
 
-		// Routes
-		app.Get("/api/messages", kit.Handler(handlers.HandleApi))
+		// Public API, callable from the origins of the websites. Version 1 is
+		// also served at its original, unversioned paths.
+		app.Route("/api", func(api chi.Router) {
+			api.Use(handlers.WithApiCors)
+
+			api.Route("/v1", func(v1 chi.Router) {
+				apiV1Routes(v1)
+				v1.Get("/openapi.json", kit.Handler(handlers.HandleApiOpenAPI))
+			})
+			apiV1Routes(api)
+			api.Options("/*", kit.Handler(handlers.HandleApiPreflight))
+		})
 	})
 
 	// Authenticated routes
@@ -60,6 +70,10 @@ func InitializeRoutes(router *chi.Mux) {
 			r.Post("/", kit.Handler(handlers.HandleMessageCreate))
 			r.Patch("/{id}", kit.Handler(handlers.HandleMessageUpdate))
 			r.Delete("/{id}", kit.Handler(handlers.HandleMessageDelete))
+			r.Post("/preview/{lang}", kit.Handler(handlers.HandleMessagePreview))
+			r.Post("/recurrence-preview", kit.Handler(handlers.HandleMessageRecurrencePreview))
+			r.Post("/{id}/preview-token", kit.Handler(handlers.HandleMessagePreviewTokenCreate))
+			r.Delete("/{id}/preview-token/{tokenId}", kit.Handler(handlers.HandleMessagePreviewTokenRevoke))
 
 			r.Get("/", kit.Handler(func(kit *kit.Kit) error {
 				return kit.Redirect(302, "/messages")
@@ -72,6 +86,8 @@ func InitializeRoutes(router *chi.Mux) {
 			r.Post("/", kit.Handler(handlers.HandleWebsiteCreate))
 			r.Patch("/{id}", kit.Handler(handlers.HandleWebsiteUpdate))
 			r.Delete("/{id}", kit.Handler(handlers.HandleWebsiteDelete))
+			r.Post("/{id}/key", kit.Handler(handlers.HandleWebsiteApiKeyCreate))
+			r.Delete("/{id}/key/{keyId}", kit.Handler(handlers.HandleWebsiteApiKeyRevoke))
 
 			r.Get("/", kit.Handler(func(kit *kit.Kit) error {
 				return kit.Redirect(302, "/websites")
@@ -79,6 +95,21 @@ func InitializeRoutes(router *chi.Mux) {
 		})
 		app.Get("/websites", kit.Handler(handlers.HandleWebsitesList))
 
+		app.Route("/language", func(r chi.Router) {
+			r.Get("/{id}", kit.Handler(handlers.HandleLanguageGet))
+			r.Post("/", kit.Handler(handlers.HandleLanguageCreate))
+			r.Patch("/{id}", kit.Handler(handlers.HandleLanguageUpdate))
+			r.Delete("/{id}", kit.Handler(handlers.HandleLanguageDelete))
+
+			r.Get("/", kit.Handler(func(kit *kit.Kit) error {
+				return kit.Redirect(302, "/languages")
+			}))
+		})
+		app.Get("/languages", kit.Handler(handlers.HandleLanguagesList))
+
+		app.Get("/simulation", kit.Handler(handlers.HandleSimulation))
+		app.Get("/simulation/messages", kit.Handler(handlers.HandleSimulationApi))
+
 		app.Get("/users", kit.Handler(handlers.HandleUsersList))
 		app.Patch("/user/{id}/role", kit.Handler(handlers.HandleUserRoleUpdate))
 		app.Delete("/user/{id}", kit.Handler(handlers.HandleUserDelete))
@@ -88,6 +119,18 @@ func InitializeRoutes(router *chi.Mux) {
 	})
 }
 
+// apiV1Routes registers the endpoints of version 1 of the public API.
+func apiV1Routes(api chi.Router) {
+	api.Get("/messages", kit.Handler(handlers.HandleApi))
+	api.Get("/messages/stream", kit.Handler(handlers.HandleApiStream))
+	api.Post("/messages/events", kit.Handler(handlers.HandleApiEvents))
+	api.Get("/messages/link", kit.Handler(handlers.HandleApiLink))
+	api.Get("/messages/fragment", kit.Handler(handlers.HandleApiFragment))
+	api.Get("/messages/fragment/{key}", kit.Handler(handlers.HandleApiFragment))
+	api.Get("/messages/page", kit.Handler(handlers.HandleApiPage))
+	api.Get("/messages/page/{key}", kit.Handler(handlers.HandleApiPage))
+}
+
 // NotFoundHandler that will be called when the requested path could
 // not be found.
 func NotFoundHandler(kit *kit.Kit) error {
@@ -0,0 +1,129 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// TimeOfDay is a time read on a wall clock, in minutes from midnight.
+type TimeOfDay int
+
+// ParseTimeOfDay parses a time of day written as 18:00.
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, errors.New("must be a time of day, such as 18:00")
+	}
+	return TimeOfDay(t.Hour()*60 + t.Minute()), nil
+}
+
+// String returns the time of day as parsed by ParseTimeOfDay.
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", t/60, t%60)
+}
+
+// Weekdays is a set of days of the week, a bit per time.Weekday. The empty
+// set stands for every day.
+type Weekdays uint8
+
+// Has reports whether a day is in the set.
+func (w Weekdays) Has(day time.Weekday) bool {
+	return w == 0 || w&(1<<day) != 0
+}
+
+// ParseWeekdays parses days of the week written as the codes of RFC 5545,
+// MO to SU, separated by commas.
+func ParseWeekdays(value string) (Weekdays, error) {
+	var w Weekdays
+	for _, code := range strings.Split(value, ",") {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if code == "" {
+			continue
+		}
+		index := slices.Index(weekdayCodes, code)
+		if index < 0 {
+			return 0, fmt.Errorf("%q is not a day of the week", code)
+		}
+		w |= 1 << ((index + 1) % 7)
+	}
+	if w == 1<<7-1 {
+		// Every day is the empty set.
+		return 0, nil
+	}
+	return w, nil
+}
+
+// Codes returns the codes of the days of the set, from Monday, as parsed by
+// ParseWeekdays.
+func (w Weekdays) Codes() []string {
+	codes := make([]string, 0, 7)
+	if w == 0 {
+		return codes
+	}
+	for index, code := range weekdayCodes {
+		if w.Has(time.Weekday((index + 1) % 7)) {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// String returns the days of the set as parsed by ParseWeekdays.
+func (w Weekdays) String() string {
+	return strings.Join(w.Codes(), ",")
+}
+
+// DailyWindow restricts the display of a message to the hours from From to
+// To of the days of Weekdays, read on the wall clock of Location.
+//
+// A window ending before it starts ends the next day: 18:00 to 08:00 is
+// overnight, and belongs to the day it starts on. A window starting and
+// ending at the same time lasts the whole day.
+type DailyWindow struct {
+	From     TimeOfDay
+	To       TimeOfDay
+	Weekdays Weekdays
+	Location *time.Location
+}
+
+// restrict calls fn with the parts of a window within the daily windows that
+// do not end before after, in order, and returns false once fn does.
+func (d *DailyWindow) restrict(window Window, after time.Time, fn func(Window) bool) bool {
+	// The window of the day before can run past midnight.
+	first := DayOf(window.Start.In(d.Location)).AddDays(-1)
+	if window.Start.Before(after) {
+		if day := DayOf(after.In(d.Location)).AddDays(-1); first.Before(day) {
+			first = day
+		}
+	}
+	last := DayOf(window.End.In(d.Location))
+
+	for day := first; !last.Before(day); day = day.AddDays(1) {
+		if !d.Weekdays.Has(day.Weekday()) {
+			continue
+		}
+		endDay := day
+		if d.To <= d.From {
+			endDay = day.AddDays(1)
+		}
+		start := Date(day.Year, day.Month, day.Day, int(d.From/60), int(d.From%60), 0, d.Location)
+		end := Date(endDay.Year, endDay.Month, endDay.Day, int(d.To/60), int(d.To%60), 0, d.Location)
+
+		if start.Before(window.Start) {
+			start = window.Start
+		}
+		if end.After(window.End) {
+			end = window.End
+		}
+		if !start.Before(end) || !end.After(after) {
+			continue
+		}
+		if !fn(Window{start, end}) {
+			return false
+		}
+	}
+	return true
+}
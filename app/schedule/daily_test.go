@@ -0,0 +1,182 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func mustParseWeekdays(t *testing.T, value string) Weekdays {
+	t.Helper()
+	w, err := ParseWeekdays(value)
+	if err != nil {
+		t.Fatalf("%s: %v", value, err)
+	}
+	return w
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	for value, want := range map[string]TimeOfDay{"00:00": 0, "08:00": 480, "18:30": 1110, "23:59": 1439} {
+		got, err := ParseTimeOfDay(value)
+		if err != nil || got != want {
+			t.Errorf("ParseTimeOfDay(%s) = %d, %v, want %d", value, got, err, want)
+		}
+		if got.String() != value {
+			t.Errorf("%d formats as %s, want %s", got, got.String(), value)
+		}
+	}
+	for _, value := range []string{"", "24:00", "8", "18:60", "6pm"} {
+		if _, err := ParseTimeOfDay(value); err == nil {
+			t.Errorf("%q should not parse", value)
+		}
+	}
+}
+
+func TestParseWeekdays(t *testing.T) {
+	tests := map[string]string{
+		"":                     "",
+		"mo, tu,WE,th,fr":      "MO,TU,WE,TH,FR",
+		"SU,SA":                "SA,SU",
+		"MO,TU,WE,TH,FR,SA,SU": "",
+	}
+	for value, want := range tests {
+		if got := mustParseWeekdays(t, value).String(); got != want {
+			t.Errorf("ParseWeekdays(%q) = %q, want %q", value, got, want)
+		}
+	}
+	if _, err := ParseWeekdays("MO,XX"); err == nil {
+		t.Error("an invalid day should not parse")
+	}
+
+	weekdays := mustParseWeekdays(t, "SA,SU")
+	if !weekdays.Has(time.Sunday) || weekdays.Has(time.Monday) {
+		t.Errorf("%s has the wrong days", weekdays)
+	}
+	if !Weekdays(0).Has(time.Wednesday) {
+		t.Error("the empty set should have every day")
+	}
+}
+
+func TestDailyWindows(t *testing.T) {
+	toronto := mustLoad(t, "America/Toronto")
+	paris := mustLoad(t, "Europe/Paris")
+
+	tests := []struct {
+		name  string
+		start string
+		end   string
+		rule  string
+		daily *DailyWindow
+		loc   *time.Location
+		want  string
+	}{
+		{
+			name:  "overnight on weekdays",
+			start: "2024-01-01T00:00:00",
+			end:   "2024-01-08T00:00:00",
+			daily: &DailyWindow{From: 18 * 60, To: 8 * 60, Weekdays: mustParseWeekdays(t, "MO,TU,WE,TH,FR"), Location: toronto},
+			loc:   toronto,
+			want: "2024-01-01T18:00:00/2024-01-02T08:00:00 2024-01-02T18:00:00/2024-01-03T08:00:00 2024-01-03T18:00:00/2024-01-04T08:00:00 " +
+				"2024-01-04T18:00:00/2024-01-05T08:00:00 2024-01-05T18:00:00/2024-01-06T08:00:00",
+		},
+		{
+			name:  "the night before the start is cut",
+			start: "2024-01-02T06:00:00",
+			end:   "2024-01-03T12:00:00",
+			daily: &DailyWindow{From: 18 * 60, To: 8 * 60, Location: toronto},
+			loc:   toronto,
+			want:  "2024-01-02T06:00:00/2024-01-02T08:00:00 2024-01-02T18:00:00/2024-01-03T08:00:00",
+		},
+		{
+			name:  "whole days are merged",
+			start: "2024-01-01T00:00:00",
+			end:   "2024-01-15T00:00:00",
+			daily: &DailyWindow{Weekdays: mustParseWeekdays(t, "SA,SU"), Location: toronto},
+			loc:   toronto,
+			want:  "2024-01-06T00:00:00/2024-01-08T00:00:00 2024-01-13T00:00:00/2024-01-15T00:00:00",
+		},
+		{
+			name:  "across daylight saving time",
+			start: "2024-03-09T00:00:00",
+			end:   "2024-03-11T00:00:00",
+			daily: &DailyWindow{From: 18 * 60, To: 8 * 60, Location: toronto},
+			loc:   toronto,
+			want:  "2024-03-09T00:00:00/2024-03-09T08:00:00 2024-03-09T18:00:00/2024-03-10T08:00:00 2024-03-10T18:00:00/2024-03-11T00:00:00",
+		},
+		{
+			name:  "in the timezone of the website",
+			start: "2024-01-01T00:00:00",
+			end:   "2024-01-02T00:00:00",
+			daily: &DailyWindow{From: 9 * 60, To: 17 * 60, Location: paris},
+			loc:   toronto,
+			want:  "2024-01-01T03:00:00/2024-01-01T11:00:00",
+		},
+		{
+			name:  "within the occurrences",
+			start: "2024-01-07T20:00:00",
+			end:   "2024-01-22T00:00:00",
+			rule:  "FREQ=WEEKLY;BYDAY=SU",
+			daily: &DailyWindow{From: 21 * 60, To: 22 * 60, Location: toronto},
+			loc:   toronto,
+			want:  "2024-01-07T21:00:00/2024-01-07T22:00:00 2024-01-14T21:00:00/2024-01-14T22:00:00 2024-01-21T21:00:00/2024-01-21T22:00:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Schedule{
+				Start:    wallClock(t, tt.start, tt.loc),
+				End:      wallClock(t, tt.end, tt.loc),
+				Location: tt.loc,
+				Daily:    tt.daily,
+			}
+			if tt.rule != "" {
+				s.Recurrence = mustParseRecurrence(t, tt.rule)
+				s.Duration = 4 * time.Hour
+			}
+			if got := formatWindows(s.Upcoming(s.Start, 100), tt.loc); got != tt.want {
+				t.Errorf("windows\n got %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleHiddenUntil(t *testing.T) {
+	toronto := mustLoad(t, "America/Toronto")
+	s := &Schedule{
+		Start:    wallClock(t, "2024-01-01T00:00:00", toronto),
+		End:      wallClock(t, "2024-02-01T00:00:00", toronto),
+		Location: toronto,
+		Daily:    &DailyWindow{From: 18 * 60, To: 8 * 60, Weekdays: mustParseWeekdays(t, "MO,TU,WE,TH,FR"), Location: toronto},
+	}
+
+	tests := []struct {
+		at     string
+		active bool
+		hidden bool
+		until  string
+	}{
+		{"2023-12-31T20:00:00", false, false, ""},
+		{"2024-01-01T12:00:00", false, true, "2024-01-01T18:00:00"},
+		{"2024-01-02T07:00:00", true, false, ""},
+		{"2024-01-06T12:00:00", false, true, "2024-01-08T18:00:00"},
+		{"2024-01-31T20:00:00", true, false, ""},
+		{"2024-02-01T12:00:00", false, false, ""},
+	}
+	for _, tt := range tests {
+		at := wallClock(t, tt.at, toronto)
+		if _, active := s.Active(at); active != tt.active {
+			t.Errorf("Active(%s) = %t, want %t", tt.at, active, tt.active)
+		}
+		until, hidden := s.HiddenUntil(at)
+		if hidden != tt.hidden {
+			t.Errorf("HiddenUntil(%s) hidden = %t, want %t", tt.at, hidden, tt.hidden)
+		}
+		got := ""
+		if !until.IsZero() {
+			got = FormatWallClock(until, toronto)
+		}
+		if got != tt.until {
+			t.Errorf("HiddenUntil(%s) = %q, want %q", tt.at, got, tt.until)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package schedule
+
+import (
+	"slices"
+	"time"
+)
+
+// maxPeriods bounds the days, weeks or months the occurrences of a rule are
+// looked for in, a rule matching no day would otherwise never end.
+const maxPeriods = 100000
+
+// Window is a period during which a message is displayed, from Start
+// included to End excluded.
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Schedule is the display period of a message, from Start to End, and its
+// recurrence rule when it has one.
+//
+// Without rule, the message is displayed for the whole period. With one, it
+// is displayed for Duration from the time of Start on each day matching the
+// rule from the day of Start, except on Exceptions. The days and times are
+// read on the wall clock of Location, so occurrences keep their time across
+// daylight saving time transitions. Occurrences end at End at the latest.
+//
+// When Daily is set, the message is only displayed during its daily windows.
+type Schedule struct {
+	Start      time.Time
+	End        time.Time
+	Location   *time.Location
+	Recurrence *Recurrence
+	Duration   time.Duration
+	Exceptions []Day
+	Daily      *DailyWindow
+}
+
+// occurrences calls fn with the occurrences of the schedule, in order, until
+// it returns false.
+func (s *Schedule) occurrences(fn func(Window) bool) {
+	if !s.Start.Before(s.End) {
+		return
+	}
+	if s.Recurrence == nil {
+		fn(Window{s.Start, s.End})
+		return
+	}
+
+	r := s.Recurrence
+	local := s.Start.In(s.Location)
+	first := DayOf(local)
+	last := DayOf(s.End.In(s.Location))
+	if r.Until != (Day{}) && r.Until.Before(last) {
+		last = r.Until
+	}
+
+	count := 0
+	for period := 0; period < maxPeriods; period++ {
+		periodStart, days := r.periodDays(first, period)
+		if last.Before(periodStart) {
+			return
+		}
+		for _, day := range days {
+			if day.Before(first) {
+				continue
+			}
+			if last.Before(day) || (r.Count > 0 && count >= r.Count) {
+				return
+			}
+			count++
+			if slices.Contains(s.Exceptions, day) {
+				continue
+			}
+
+			start := Date(day.Year, day.Month, day.Day, local.Hour(), local.Minute(), local.Second(), s.Location)
+			if start.Before(s.Start) {
+				// The first day, when Start is the second of two repeated times.
+				start = s.Start
+			}
+			if !start.Before(s.End) {
+				return
+			}
+			end := start.Add(s.Duration)
+			if end.After(s.End) {
+				end = s.End
+			}
+			if !fn(Window{start, end}) {
+				return
+			}
+		}
+	}
+}
+
+// windows calls fn with the periods during which the message is displayed,
+// in order, until it returns false. Periods ended before after may be
+// skipped. Overlapping occurrences are merged, and restricted to the daily
+// windows.
+func (s *Schedule) windows(after time.Time, fn func(Window) bool) {
+	if s.Daily == nil {
+		merge(s.occurrences, fn)
+		return
+	}
+	merge(func(yield func(Window) bool) {
+		merge(s.occurrences, func(occurrence Window) bool {
+			if !occurrence.End.After(after) {
+				return true
+			}
+			return s.Daily.restrict(occurrence, after, yield)
+		})
+	}, fn)
+}
+
+// merge calls fn with the windows of source, in order, merging the ones that
+// overlap or follow each other, until it returns false.
+func merge(source func(func(Window) bool), fn func(Window) bool) {
+	var pending Window
+	stopped := false
+	source(func(window Window) bool {
+		if pending.Start.IsZero() {
+			pending = window
+			return true
+		}
+		if !window.Start.After(pending.End) {
+			if window.End.After(pending.End) {
+				pending.End = window.End
+			}
+			return true
+		}
+		if !fn(pending) {
+			stopped = true
+			return false
+		}
+		pending = window
+		return true
+	})
+	if !stopped && !pending.Start.IsZero() {
+		fn(pending)
+	}
+}
+
+// Active returns the period during which the message is displayed at an
+// instant, and whether it is displayed.
+func (s *Schedule) Active(at time.Time) (Window, bool) {
+	var active Window
+	found := false
+	s.windows(at, func(window Window) bool {
+		if window.Start.After(at) {
+			return false
+		}
+		if window.End.After(at) {
+			active, found = window, true
+			return false
+		}
+		return true
+	})
+	return active, found
+}
+
+// NextBoundary returns the first instant after an instant at which the
+// message appears or disappears, the zero time when it never does again.
+func (s *Schedule) NextBoundary(after time.Time) time.Time {
+	var next time.Time
+	s.windows(after, func(window Window) bool {
+		switch {
+		case window.Start.After(after):
+			next = window.Start
+		case window.End.After(after):
+			next = window.End
+		default:
+			return true
+		}
+		return false
+	})
+	return next
+}
+
+// Upcoming returns at most n periods during which the message is displayed
+// not ended at an instant, the current one first.
+func (s *Schedule) Upcoming(after time.Time, n int) []Window {
+	upcoming := make([]Window, 0, n)
+	if n <= 0 {
+		return upcoming
+	}
+	s.windows(after, func(window Window) bool {
+		if window.End.After(after) {
+			upcoming = append(upcoming, window)
+		}
+		return len(upcoming) < n
+	})
+	return upcoming
+}
+
+// HiddenUntil reports whether the daily windows hide the message at an
+// instant within one of its occurrences, and returns when it is displayed
+// again, the zero time when it is not.
+func (s *Schedule) HiddenUntil(at time.Time) (time.Time, bool) {
+	if s.Daily == nil {
+		return time.Time{}, false
+	}
+	if _, active := s.Active(at); active {
+		return time.Time{}, false
+	}
+	occurrences := *s
+	occurrences.Daily = nil
+	if _, active := occurrences.Active(at); !active {
+		return time.Time{}, false
+	}
+	return s.NextBoundary(at), true
+}
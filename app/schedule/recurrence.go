@@ -0,0 +1,354 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ part of a recurrence rule.
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+)
+
+// Day is a calendar day, as read on the wall clock of a timezone.
+type Day struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DayOf returns the day of t in its location.
+func DayOf(t time.Time) Day {
+	return Day{t.Year(), t.Month(), t.Day()}
+}
+
+// ParseDay parses a day written as 2006-01-02.
+func ParseDay(value string) (Day, error) {
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return Day{}, fmt.Errorf("%q is not a date", value)
+	}
+	return DayOf(t), nil
+}
+
+func (d Day) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// AddDays returns the day n days after d.
+func (d Day) AddDays(n int) Day {
+	return DayOf(time.Date(d.Year, d.Month, d.Day+n, 0, 0, 0, 0, time.UTC))
+}
+
+func (d Day) Before(other Day) bool {
+	if d.Year != other.Year {
+		return d.Year < other.Year
+	}
+	if d.Month != other.Month {
+		return d.Month < other.Month
+	}
+	return d.Day < other.Day
+}
+
+func (d Day) Weekday() time.Weekday {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC).Weekday()
+}
+
+// WeekdayNum is a day of the BYDAY part of a rule. Monthly rules can number
+// it within the month, from its end when negative: -1SU is the last Sunday.
+type WeekdayNum struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// weekdayCodes are the weekdays of RFC 5545, from Monday, the start of the
+// weeks of weekly rules.
+var weekdayCodes = []string{"MO", "TU", "WE", "TH", "FR", "SA", "SU"}
+
+func weekdayCode(weekday time.Weekday) string {
+	return weekdayCodes[(weekday+6)%7]
+}
+
+// Recurrence is a recurrence rule, the subset of the RRULE of RFC 5545 made
+// of FREQ (DAILY, WEEKLY or MONTHLY), INTERVAL, BYDAY, BYMONTHDAY, COUNT and
+// UNTIL.
+type Recurrence struct {
+	Frequency  Frequency
+	Interval   int
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	// Count is the number of occurrences, exceptions included, when not 0.
+	Count int
+	// Until is the last day an occurrence starts on, when not zero.
+	Until Day
+}
+
+// ParseRecurrence parses a recurrence rule such as FREQ=WEEKLY;BYDAY=SU. The
+// RRULE: prefix is optional. UNTIL is a day, 20241231, or an instant in UTC,
+// 20241231T235959Z, whose day is kept.
+func ParseRecurrence(rule string) (*Recurrence, error) {
+	rule = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(rule)), "RRULE:")
+	if rule == "" {
+		return nil, errors.New("must not be empty")
+	}
+
+	r := &Recurrence{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(rule, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%q is not a NAME=VALUE part", part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%s is repeated", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			r.Frequency = Frequency(value)
+			if !slices.Contains([]Frequency{Daily, Weekly, Monthly}, r.Frequency) {
+				return nil, fmt.Errorf("FREQ must be DAILY, WEEKLY or MONTHLY")
+			}
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err != nil || r.Interval < 1 {
+				return nil, errors.New("INTERVAL must be a positive number")
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if err != nil || r.Count < 1 {
+				return nil, errors.New("COUNT must be a positive number")
+			}
+		case "UNTIL":
+			until, err := time.Parse("20060102", value)
+			if err != nil {
+				until, err = time.Parse("20060102T150405Z", value)
+			}
+			if err != nil {
+				return nil, errors.New("UNTIL must be a date, such as 20241231")
+			}
+			r.Until = DayOf(until)
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekdayNum, err := parseWeekdayNum(day)
+				if err != nil {
+					return nil, err
+				}
+				r.ByDay = append(r.ByDay, weekdayNum)
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				monthDay, err := strconv.Atoi(day)
+				if err != nil || monthDay == 0 || monthDay < -31 || monthDay > 31 {
+					return nil, fmt.Errorf("%q is not a day of the month", day)
+				}
+				r.ByMonthDay = append(r.ByMonthDay, monthDay)
+			}
+		default:
+			return nil, fmt.Errorf("%s is not supported", name)
+		}
+	}
+
+	if r.Frequency == "" {
+		return nil, errors.New("FREQ is required")
+	}
+	if r.Count > 0 && r.Until != (Day{}) {
+		return nil, errors.New("COUNT and UNTIL cannot be combined")
+	}
+	if r.Frequency != Monthly {
+		if len(r.ByMonthDay) > 0 {
+			return nil, errors.New("BYMONTHDAY is only supported by MONTHLY rules")
+		}
+		for _, day := range r.ByDay {
+			if day.Ordinal != 0 {
+				return nil, errors.New("numbered BYDAY days are only supported by MONTHLY rules")
+			}
+		}
+	}
+	return r, nil
+}
+
+func parseWeekdayNum(value string) (WeekdayNum, error) {
+	if len(value) < 2 {
+		return WeekdayNum{}, fmt.Errorf("%q is not a day of the week", value)
+	}
+	code := value[len(value)-2:]
+	index := slices.Index(weekdayCodes, code)
+	if index < 0 {
+		return WeekdayNum{}, fmt.Errorf("%q is not a day of the week", value)
+	}
+
+	weekdayNum := WeekdayNum{Weekday: time.Weekday((index + 1) % 7)}
+	if ordinal := value[:len(value)-2]; ordinal != "" {
+		n, err := strconv.Atoi(ordinal)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return WeekdayNum{}, fmt.Errorf("%q is not a day of the week", value)
+		}
+		weekdayNum.Ordinal = n
+	}
+	return weekdayNum, nil
+}
+
+// String returns the rule in its canonical form.
+func (r *Recurrence) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, day := range r.ByDay {
+			code := weekdayCode(day.Weekday)
+			if day.Ordinal != 0 {
+				code = strconv.Itoa(day.Ordinal) + code
+			}
+			days = append(days, code)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, 0, len(r.ByMonthDay))
+		for _, day := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(day))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+	if r.Until != (Day{}) {
+		parts = append(parts, fmt.Sprintf("UNTIL=%04d%02d%02d", r.Until.Year, r.Until.Month, r.Until.Day))
+	}
+	return strings.Join(parts, ";")
+}
+
+// periodDays returns the first day of a period of the rule, the period-th
+// day, week or month from the one of first, and the days of that period
+// matching the rule, in order.
+func (r *Recurrence) periodDays(first Day, period int) (Day, []Day) {
+	switch r.Frequency {
+	case Daily:
+		day := first.AddDays(period * r.Interval)
+		if len(r.ByDay) > 0 && !slices.ContainsFunc(r.ByDay, func(d WeekdayNum) bool { return d.Weekday == day.Weekday() }) {
+			return day, nil
+		}
+		return day, []Day{day}
+
+	case Weekly:
+		monday := first.AddDays(-int((first.Weekday() + 6) % 7))
+		start := monday.AddDays(7 * period * r.Interval)
+		weekdays := []time.Weekday{first.Weekday()}
+		if len(r.ByDay) > 0 {
+			weekdays = weekdays[:0]
+			for _, day := range r.ByDay {
+				weekdays = append(weekdays, day.Weekday)
+			}
+		}
+		days := make([]Day, 0, len(weekdays))
+		for _, weekday := range weekdays {
+			days = append(days, start.AddDays(int((weekday+6)%7)))
+		}
+		return start, sortDays(days)
+
+	default:
+		start := DayOf(time.Date(first.Year, first.Month+time.Month(period*r.Interval), 1, 0, 0, 0, 0, time.UTC))
+		daysInMonth := time.Date(start.Year, start.Month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		days := make([]Day, 0)
+		for _, monthDay := range r.ByMonthDay {
+			if monthDay < 0 {
+				monthDay = daysInMonth + monthDay + 1
+			}
+			if monthDay >= 1 && monthDay <= daysInMonth {
+				days = append(days, Day{start.Year, start.Month, monthDay})
+			}
+		}
+		for _, day := range r.ByDay {
+			matching := make([]Day, 0, 5)
+			for monthDay := 1; monthDay <= daysInMonth; monthDay++ {
+				date := Day{start.Year, start.Month, monthDay}
+				if date.Weekday() == day.Weekday {
+					matching = append(matching, date)
+				}
+			}
+			switch {
+			case day.Ordinal == 0:
+				days = append(days, matching...)
+			case day.Ordinal > 0 && day.Ordinal <= len(matching):
+				days = append(days, matching[day.Ordinal-1])
+			case day.Ordinal < 0 && -day.Ordinal <= len(matching):
+				days = append(days, matching[len(matching)+day.Ordinal])
+			}
+		}
+		if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 && first.Day <= daysInMonth {
+			days = append(days, Day{start.Year, start.Month, first.Day})
+		}
+		return start, sortDays(days)
+	}
+}
+
+// sortDays sorts days and removes the duplicates.
+func sortDays(days []Day) []Day {
+	slices.SortFunc(days, func(a, b Day) int {
+		switch {
+		case a.Before(b):
+			return -1
+		case b.Before(a):
+			return 1
+		}
+		return 0
+	})
+	return slices.Compact(days)
+}
+
+// ParseExceptions parses the days of the occurrences to skip, separated by
+// commas, spaces or new lines.
+func ParseExceptions(value string) ([]Day, error) {
+	exceptions := make([]Day, 0)
+	for _, field := range strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n' || r == '\r' || r == '\t'
+	}) {
+		day, err := ParseDay(field)
+		if err != nil {
+			return nil, err
+		}
+		exceptions = append(exceptions, day)
+	}
+	return sortDays(exceptions), nil
+}
+
+// FormatExceptions formats days as parsed by ParseExceptions.
+func FormatExceptions(exceptions []Day) string {
+	days := make([]string, 0, len(exceptions))
+	for _, day := range exceptions {
+		days = append(days, day.String())
+	}
+	return strings.Join(days, ", ")
+}
+
+// ParseDuration parses a duration written as hours and minutes, 2:30, which
+// can exceed a day, 60:00.
+func ParseDuration(value string) (time.Duration, error) {
+	hours, minutes, ok := strings.Cut(strings.TrimSpace(value), ":")
+	h, errHours := strconv.Atoi(hours)
+	m, errMinutes := strconv.Atoi(minutes)
+	if !ok || errHours != nil || errMinutes != nil || h < 0 || m < 0 || m > 59 || h*60+m == 0 {
+		return 0, errors.New("must be a duration in hours and minutes, such as 2:30")
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// FormatDuration formats a duration as parsed by ParseDuration.
+func FormatDuration(d time.Duration) string {
+	minutes := int(d / time.Minute)
+	return fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
+}
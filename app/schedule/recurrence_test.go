@@ -0,0 +1,304 @@
+package schedule
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func mustParseRecurrence(t *testing.T, rule string) *Recurrence {
+	t.Helper()
+	r, err := ParseRecurrence(rule)
+	if err != nil {
+		t.Fatalf("%s: %v", rule, err)
+	}
+	return r
+}
+
+func wallClock(t *testing.T, value string, loc *time.Location) time.Time {
+	t.Helper()
+	instant, err := ParseWallClock(value, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return instant
+}
+
+func formatWindows(windows []Window, loc *time.Location) string {
+	formatted := make([]string, 0, len(windows))
+	for _, window := range windows {
+		formatted = append(formatted, FormatWallClock(window.Start, loc)+"/"+FormatWallClock(window.End, loc))
+	}
+	return strings.Join(formatted, " ")
+}
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		rule string
+		want string
+	}{
+		{"FREQ=DAILY", "FREQ=DAILY"},
+		{"RRULE:freq=weekly;byday=su,mo", "FREQ=WEEKLY;BYDAY=SU,MO"},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=FR", "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR"},
+		{"FREQ=MONTHLY;BYDAY=-1FR", "FREQ=MONTHLY;BYDAY=-1FR"},
+		{"FREQ=MONTHLY;BYMONTHDAY=1,-1;COUNT=6", "FREQ=MONTHLY;BYMONTHDAY=1,-1;COUNT=6"},
+		{"FREQ=DAILY;UNTIL=20241231T235959Z", "FREQ=DAILY;UNTIL=20241231"},
+	}
+	for _, tt := range tests {
+		if got := mustParseRecurrence(t, tt.rule).String(); got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.rule, got, tt.want)
+		}
+	}
+
+	for _, rule := range []string{
+		"",
+		"FREQ=YEARLY",
+		"INTERVAL=2",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;COUNT=2;UNTIL=20241231",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=DAILY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=DAILY;BYHOUR=9",
+		"FREQ",
+	} {
+		if _, err := ParseRecurrence(rule); err == nil {
+			t.Errorf("%q should not parse", rule)
+		}
+	}
+}
+
+func TestScheduleOccurrences(t *testing.T) {
+	toronto := mustLoad(t, "America/Toronto")
+
+	tests := []struct {
+		name       string
+		start      string
+		end        string
+		rule       string
+		duration   time.Duration
+		exceptions string
+		want       string
+	}{
+		{
+			name:     "daily",
+			start:    "2024-01-01T09:00:00",
+			end:      "2024-01-04T00:00:00",
+			rule:     "FREQ=DAILY",
+			duration: time.Hour,
+			want:     "2024-01-01T09:00:00/2024-01-01T10:00:00 2024-01-02T09:00:00/2024-01-02T10:00:00 2024-01-03T09:00:00/2024-01-03T10:00:00",
+		},
+		{
+			name:     "weekly on Sundays from a Wednesday",
+			start:    "2024-01-03T20:00:00",
+			end:      "2024-01-22T00:00:00",
+			rule:     "FREQ=WEEKLY;BYDAY=SU",
+			duration: 2 * time.Hour,
+			want:     "2024-01-07T20:00:00/2024-01-07T22:00:00 2024-01-14T20:00:00/2024-01-14T22:00:00 2024-01-21T20:00:00/2024-01-21T22:00:00",
+		},
+		{
+			name:     "every other week",
+			start:    "2024-01-01T08:00:00",
+			end:      "2024-02-01T00:00:00",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TU",
+			duration: time.Hour,
+			want:     "2024-01-01T08:00:00/2024-01-01T09:00:00 2024-01-02T08:00:00/2024-01-02T09:00:00 2024-01-15T08:00:00/2024-01-15T09:00:00 2024-01-16T08:00:00/2024-01-16T09:00:00 2024-01-29T08:00:00/2024-01-29T09:00:00 2024-01-30T08:00:00/2024-01-30T09:00:00",
+		},
+		{
+			name:     "last Friday of the month",
+			start:    "2024-01-01T12:00:00",
+			end:      "2024-04-01T00:00:00",
+			rule:     "FREQ=MONTHLY;BYDAY=-1FR",
+			duration: 30 * time.Minute,
+			want:     "2024-01-26T12:00:00/2024-01-26T12:30:00 2024-02-23T12:00:00/2024-02-23T12:30:00 2024-03-29T12:00:00/2024-03-29T12:30:00",
+		},
+		{
+			name:     "months without their 31st are skipped",
+			start:    "2024-01-31T09:00:00",
+			end:      "2024-06-01T00:00:00",
+			rule:     "FREQ=MONTHLY",
+			duration: time.Hour,
+			want:     "2024-01-31T09:00:00/2024-01-31T10:00:00 2024-03-31T09:00:00/2024-03-31T10:00:00 2024-05-31T09:00:00/2024-05-31T10:00:00",
+		},
+		{
+			name:     "last day of the month",
+			start:    "2024-01-15T09:00:00",
+			end:      "2024-04-01T00:00:00",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1",
+			duration: time.Hour,
+			want:     "2024-01-31T09:00:00/2024-01-31T10:00:00 2024-02-29T09:00:00/2024-02-29T10:00:00 2024-03-31T09:00:00/2024-03-31T10:00:00",
+		},
+		{
+			name:       "exceptions count towards COUNT",
+			start:      "2024-01-01T09:00:00",
+			end:        "2025-01-01T00:00:00",
+			rule:       "FREQ=DAILY;COUNT=3",
+			duration:   time.Hour,
+			exceptions: "2024-01-02",
+			want:       "2024-01-01T09:00:00/2024-01-01T10:00:00 2024-01-03T09:00:00/2024-01-03T10:00:00",
+		},
+		{
+			name:     "until",
+			start:    "2024-01-01T09:00:00",
+			end:      "2025-01-01T00:00:00",
+			rule:     "FREQ=DAILY;UNTIL=20240102",
+			duration: time.Hour,
+			want:     "2024-01-01T09:00:00/2024-01-01T10:00:00 2024-01-02T09:00:00/2024-01-02T10:00:00",
+		},
+		{
+			name:     "the end of the display period cuts occurrences",
+			start:    "2024-01-01T09:00:00",
+			end:      "2024-01-02T09:30:00",
+			rule:     "FREQ=DAILY",
+			duration: time.Hour,
+			want:     "2024-01-01T09:00:00/2024-01-01T10:00:00 2024-01-02T09:00:00/2024-01-02T09:30:00",
+		},
+		{
+			name:     "across daylight saving time",
+			start:    "2024-03-09T09:00:00",
+			end:      "2024-03-12T00:00:00",
+			rule:     "FREQ=DAILY",
+			duration: time.Hour,
+			want:     "2024-03-09T09:00:00/2024-03-09T10:00:00 2024-03-10T09:00:00/2024-03-10T10:00:00 2024-03-11T09:00:00/2024-03-11T10:00:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exceptions, err := ParseExceptions(tt.exceptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s := &Schedule{
+				Start:      wallClock(t, tt.start, toronto),
+				End:        wallClock(t, tt.end, toronto),
+				Location:   toronto,
+				Recurrence: mustParseRecurrence(t, tt.rule),
+				Duration:   tt.duration,
+				Exceptions: exceptions,
+			}
+			if got := formatWindows(s.Upcoming(s.Start, 100), toronto); got != tt.want {
+				t.Errorf("occurrences\n got %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleOccurrencesKeepTheirUTCOffset(t *testing.T) {
+	toronto := mustLoad(t, "America/Toronto")
+	s := &Schedule{
+		Start:      wallClock(t, "2024-03-09T09:00:00", toronto),
+		End:        wallClock(t, "2024-03-11T00:00:00", toronto),
+		Location:   toronto,
+		Recurrence: mustParseRecurrence(t, "FREQ=DAILY"),
+		Duration:   time.Hour,
+	}
+	upcoming := s.Upcoming(s.Start, 2)
+	if len(upcoming) != 2 {
+		t.Fatalf("got %d occurrences, want 2", len(upcoming))
+	}
+	if got := upcoming[0].Start.Format(time.RFC3339); got != "2024-03-09T14:00:00Z" {
+		t.Errorf("first occurrence at %s", got)
+	}
+	if got := upcoming[1].Start.Format(time.RFC3339); got != "2024-03-10T13:00:00Z" {
+		t.Errorf("second occurrence at %s", got)
+	}
+}
+
+func TestScheduleActiveAndNextBoundary(t *testing.T) {
+	utc := time.UTC
+	s := &Schedule{
+		Start:      wallClock(t, "2024-01-01T09:00:00", utc),
+		End:        wallClock(t, "2024-01-10T00:00:00", utc),
+		Location:   utc,
+		Recurrence: mustParseRecurrence(t, "FREQ=DAILY;INTERVAL=2"),
+		Duration:   time.Hour,
+	}
+
+	tests := []struct {
+		at       string
+		active   bool
+		boundary string
+	}{
+		{"2023-12-31T00:00:00", false, "2024-01-01T09:00:00"},
+		{"2024-01-01T09:00:00", true, "2024-01-01T10:00:00"},
+		{"2024-01-01T09:59:59", true, "2024-01-01T10:00:00"},
+		{"2024-01-01T10:00:00", false, "2024-01-03T09:00:00"},
+		{"2024-01-02T09:30:00", false, "2024-01-03T09:00:00"},
+		{"2024-01-09T09:30:00", true, "2024-01-09T10:00:00"},
+		{"2024-01-09T10:00:00", false, ""},
+	}
+	for _, tt := range tests {
+		at := wallClock(t, tt.at, utc)
+		if _, active := s.Active(at); active != tt.active {
+			t.Errorf("Active(%s) = %t, want %t", tt.at, active, tt.active)
+		}
+		got := ""
+		if boundary := s.NextBoundary(at); !boundary.IsZero() {
+			got = FormatWallClock(boundary, utc)
+		}
+		if got != tt.boundary {
+			t.Errorf("NextBoundary(%s) = %q, want %q", tt.at, got, tt.boundary)
+		}
+	}
+}
+
+func TestScheduleMergesOverlappingOccurrences(t *testing.T) {
+	utc := time.UTC
+	s := &Schedule{
+		Start:      wallClock(t, "2024-01-01T09:00:00", utc),
+		End:        wallClock(t, "2024-01-03T12:00:00", utc),
+		Location:   utc,
+		Recurrence: mustParseRecurrence(t, "FREQ=DAILY"),
+		Duration:   30 * time.Hour,
+	}
+
+	window, active := s.Active(wallClock(t, "2024-01-02T10:00:00", utc))
+	if !active {
+		t.Fatal("the message should be displayed")
+	}
+	if got := FormatWallClock(window.End, utc); got != "2024-01-03T12:00:00" {
+		t.Errorf("the window ends at %s", got)
+	}
+	if got := FormatWallClock(s.NextBoundary(window.Start), utc); got != "2024-01-03T12:00:00" {
+		t.Errorf("the next boundary is %s", got)
+	}
+}
+
+func TestScheduleWithoutRecurrence(t *testing.T) {
+	utc := time.UTC
+	s := &Schedule{
+		Start:    wallClock(t, "2024-01-01T09:00:00", utc),
+		End:      wallClock(t, "2024-01-02T09:00:00", utc),
+		Location: utc,
+	}
+	if _, active := s.Active(wallClock(t, "2024-01-01T12:00:00", utc)); !active {
+		t.Error("the message should be displayed during its period")
+	}
+	if got := FormatWallClock(s.NextBoundary(s.Start), utc); got != "2024-01-02T09:00:00" {
+		t.Errorf("the next boundary is %s", got)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	for value, want := range map[string]time.Duration{
+		"1:00":  time.Hour,
+		"0:30":  30 * time.Minute,
+		"60:15": 60*time.Hour + 15*time.Minute,
+	} {
+		got, err := ParseDuration(value)
+		if err != nil || got != want {
+			t.Errorf("ParseDuration(%s) = %s, %v, want %s", value, got, err, want)
+		}
+		if formatted := FormatDuration(got); formatted != value {
+			t.Errorf("FormatDuration(%s) = %s, want %s", got, formatted, value)
+		}
+	}
+	for _, value := range []string{"", "0:00", "1", "1:60", "-1:00", "a:b"} {
+		if _, err := ParseDuration(value); err == nil {
+			t.Errorf("%q should not parse", value)
+		}
+	}
+}
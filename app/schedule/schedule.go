@@ -0,0 +1,166 @@
+// Package schedule converts the dates of the admin UI, read on the wall clock
+// of a timezone, to the instants stored in the database.
+//
+// Every instant is stored in UTC. Messages keep the IANA name of the timezone
+// their schedule was written in, and users the timezone the admin UI displays
+// dates in. The TIMEZONE environment variable is the default of both.
+package schedule
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	// The timezone database is embedded, as the runtime images do not always
+	// ship one.
+	_ "time/tzdata"
+)
+
+// DefaultTimezone is the timezone of the application when TIMEZONE is not set.
+const DefaultTimezone = "America/Toronto"
+
+// WallClockLayout is the layout of the wall-clock dates of the forms, without
+// offset.
+const WallClockLayout = "2006-01-02T15:04:05"
+
+// Timezones lists the timezones suggested by the forms. Any IANA name is
+// accepted.
+var Timezones = []string{
+	"UTC",
+	"America/St_Johns",
+	"America/Halifax",
+	"America/Toronto",
+	"America/New_York",
+	"America/Chicago",
+	"America/Winnipeg",
+	"America/Denver",
+	"America/Edmonton",
+	"America/Phoenix",
+	"America/Los_Angeles",
+	"America/Vancouver",
+	"America/Anchorage",
+	"Pacific/Honolulu",
+	"America/Mexico_City",
+	"America/Sao_Paulo",
+	"Europe/London",
+	"Europe/Dublin",
+	"Europe/Lisbon",
+	"Europe/Paris",
+	"Europe/Brussels",
+	"Europe/Berlin",
+	"Europe/Madrid",
+	"Europe/Rome",
+	"Europe/Athens",
+	"Europe/Helsinki",
+	"Europe/Istanbul",
+	"Africa/Casablanca",
+	"Africa/Johannesburg",
+	"Asia/Dubai",
+	"Asia/Kolkata",
+	"Asia/Singapore",
+	"Asia/Shanghai",
+	"Asia/Tokyo",
+	"Australia/Perth",
+	"Australia/Sydney",
+	"Pacific/Auckland",
+}
+
+// wallClockLayouts are the layouts accepted by ParseWallClock. The offset of
+// RFC 3339 dates, added by the date pickers of browsers, is ignored.
+var wallClockLayouts = []string{
+	time.RFC3339,
+	WallClockLayout,
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// LoadLocation returns the location of an IANA timezone name. Unlike
+// time.LoadLocation, it rejects the empty name and Local, whose meaning
+// depends on the server.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, errors.New("must be an IANA timezone, such as America/Toronto")
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.New("must be an IANA timezone, such as America/Toronto")
+	}
+	return loc, nil
+}
+
+// DefaultLocation returns the location of the TIMEZONE environment variable,
+// or of DefaultTimezone when it is not a valid timezone.
+func DefaultLocation() *time.Location {
+	if loc, err := LoadLocation(os.Getenv("TIMEZONE")); err == nil {
+		return loc
+	}
+	loc, _ := LoadLocation(DefaultTimezone)
+	return loc
+}
+
+// Location returns the location of a timezone name, or the default location
+// when the name is empty or invalid.
+func Location(name string) *time.Location {
+	if loc, err := LoadLocation(name); err == nil {
+		return loc
+	}
+	return DefaultLocation()
+}
+
+// Date returns the instant, in UTC, at which the wall clock of loc shows the
+// given date and time.
+//
+// Wall-clock times skipped by a forward transition are moved forward by the
+// length of the gap, as the clocks are: 02:30 on the night clocks jump from
+// 02:00 to 03:00 is 03:30. Wall-clock times repeated by a backward transition
+// are the first of the two instants, before the clocks go back.
+func Date(year int, month time.Month, day, hour, min, sec int, loc *time.Location) time.Time {
+	wall := time.Date(year, month, day, hour, min, sec, 0, time.UTC)
+
+	// Transitions are months apart, so the offsets a day before and a day
+	// after are the only ones the wall clock can be read in.
+	_, offsetBefore := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, offsetAfter := wall.Add(24 * time.Hour).In(loc).Zone()
+
+	var instant time.Time
+	for _, offset := range []int{offsetBefore, offsetAfter} {
+		candidate := wall.Add(-time.Duration(offset) * time.Second)
+		if !sameWallClock(candidate.In(loc), wall) {
+			continue
+		}
+		if instant.IsZero() || candidate.Before(instant) {
+			instant = candidate
+		}
+	}
+	if instant.IsZero() {
+		// In a gap, the offset before the transition moves the time forward.
+		instant = wall.Add(-time.Duration(offsetBefore) * time.Second)
+	}
+	return instant.UTC()
+}
+
+// ParseWallClock parses a date read on the wall clock of loc and returns its
+// instant in UTC, as Date does. Dates without a time are at midnight.
+func ParseWallClock(value string, loc *time.Location) (time.Time, error) {
+	for _, layout := range wallClockLayouts {
+		parsed, err := time.Parse(layout, value)
+		if err != nil {
+			continue
+		}
+		return Date(parsed.Year(), parsed.Month(), parsed.Day(), parsed.Hour(), parsed.Minute(), parsed.Second(), loc), nil
+	}
+	return time.Time{}, errors.New("must be a date and a time")
+}
+
+// FormatWallClock formats an instant as read on the wall clock of loc, with
+// WallClockLayout.
+func FormatWallClock(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(WallClockLayout)
+}
+
+func sameWallClock(t time.Time, wall time.Time) bool {
+	return t.Year() == wall.Year() && t.Month() == wall.Month() && t.Day() == wall.Day() &&
+		t.Hour() == wall.Hour() && t.Minute() == wall.Minute() && t.Second() == wall.Second()
+}
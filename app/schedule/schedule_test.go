@@ -0,0 +1,134 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func TestDateAcrossTransitions(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone string
+		wall     string
+		want     string
+	}{
+		{"standard time", "America/Toronto", "2024-01-15T09:00:00", "2024-01-15T14:00:00Z"},
+		{"daylight time", "America/Toronto", "2024-07-01T09:00:00", "2024-07-01T13:00:00Z"},
+		{"before spring forward", "America/Toronto", "2024-03-10T01:59:00", "2024-03-10T06:59:00Z"},
+		{"in the spring gap", "America/Toronto", "2024-03-10T02:30:00", "2024-03-10T07:30:00Z"},
+		{"after spring forward", "America/Toronto", "2024-03-10T03:00:00", "2024-03-10T07:00:00Z"},
+		{"before fall back", "America/Toronto", "2024-11-03T00:59:00", "2024-11-03T04:59:00Z"},
+		{"repeated by fall back", "America/Toronto", "2024-11-03T01:30:00", "2024-11-03T05:30:00Z"},
+		{"after fall back", "America/Toronto", "2024-11-03T02:00:00", "2024-11-03T07:00:00Z"},
+		{"in the spring gap in Paris", "Europe/Paris", "2024-03-31T02:15:00", "2024-03-31T01:15:00Z"},
+		{"repeated by fall back in Paris", "Europe/Paris", "2024-10-27T02:15:00", "2024-10-27T00:15:00Z"},
+		{"half hour gap", "Australia/Lord_Howe", "2024-10-06T02:15:00", "2024-10-05T15:45:00Z"},
+		{"southern fall back", "Australia/Sydney", "2024-04-07T02:30:00", "2024-04-06T15:30:00Z"},
+		{"UTC", "UTC", "2024-03-10T02:30:00", "2024-03-10T02:30:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseWallClock(tt.wall, mustLoad(t, tt.timezone))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, _ := time.Parse(time.RFC3339, tt.want)
+			if !got.Equal(want) {
+				t.Errorf("%s in %s = %s, want %s", tt.wall, tt.timezone, got.Format(time.RFC3339), tt.want)
+			}
+			if got.Location() != time.UTC {
+				t.Errorf("%s in %s is not in UTC", tt.wall, tt.timezone)
+			}
+		})
+	}
+}
+
+func TestWallClockRoundTrip(t *testing.T) {
+	loc := mustLoad(t, "America/Toronto")
+
+	// Every hour of a year goes through both transitions. Instants repeated by
+	// the fall back only round trip from their first occurrence.
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for instant := start; instant.Year() == 2024; instant = instant.Add(time.Hour) {
+		wall := FormatWallClock(instant, loc)
+		got, err := ParseWallClock(wall, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		repeated := !got.Equal(instant) && got.Add(time.Hour).Equal(instant)
+		if !got.Equal(instant) && !repeated {
+			t.Errorf("%s read as %s is %s", instant.Format(time.RFC3339), wall, got.Format(time.RFC3339))
+		}
+	}
+}
+
+func TestParseWallClockIgnoresOffsets(t *testing.T) {
+	loc := mustLoad(t, "Europe/Paris")
+	want := time.Date(2024, 7, 1, 7, 0, 0, 0, time.UTC)
+
+	for _, value := range []string{
+		"2024-07-01T09:00:00Z",
+		"2024-07-01T09:00:00-05:00",
+		"2024-07-01T09:00:00",
+		"2024-07-01T09:00",
+		"2024-07-01 09:00",
+	} {
+		got, err := ParseWallClock(value, loc)
+		if err != nil {
+			t.Fatalf("%s: %v", value, err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("%s = %s, want %s", value, got.Format(time.RFC3339), want.Format(time.RFC3339))
+		}
+	}
+
+	if _, err := ParseWallClock("next tuesday", loc); err == nil {
+		t.Error("an invalid date should not parse")
+	}
+}
+
+func TestLoadLocation(t *testing.T) {
+	for _, name := range []string{"UTC", "America/Toronto", "Asia/Kolkata"} {
+		if _, err := LoadLocation(name); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "Local", "Mars/Olympus_Mons", "../etc/passwd"} {
+		if _, err := LoadLocation(name); err == nil {
+			t.Errorf("%q should not be a timezone", name)
+		}
+	}
+	for _, name := range Timezones {
+		if _, err := LoadLocation(name); err != nil {
+			t.Errorf("suggested timezone %s: %v", name, err)
+		}
+	}
+}
+
+func TestDefaultLocation(t *testing.T) {
+	t.Setenv("TIMEZONE", "Europe/Paris")
+	if got := DefaultLocation().String(); got != "Europe/Paris" {
+		t.Errorf("DefaultLocation() = %s, want Europe/Paris", got)
+	}
+	if got := Location("").String(); got != "Europe/Paris" {
+		t.Errorf("Location(\"\") = %s, want Europe/Paris", got)
+	}
+	if got := Location("Asia/Tokyo").String(); got != "Asia/Tokyo" {
+		t.Errorf("Location(Asia/Tokyo) = %s", got)
+	}
+
+	t.Setenv("TIMEZONE", "")
+	if got := DefaultLocation().String(); got != DefaultTimezone {
+		t.Errorf("DefaultLocation() without TIMEZONE = %s, want %s", got, DefaultTimezone)
+	}
+}
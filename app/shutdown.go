@@ -0,0 +1,9 @@
+package app
+
+import "messages/app/handlers"
+
+// Shutdown saves what the handlers hold in memory. It is called once the
+// server stopped serving requests.
+func Shutdown() {
+	handlers.FlushMessageStats()
+}
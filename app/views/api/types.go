@@ -0,0 +1,19 @@
+package api
+
+// FragmentMessage is a message rendered by the HTML fragment endpoint.
+type FragmentMessage struct {
+	ID       int64
+	Revision int64
+	Title    string
+	// Body is the message already rendered from markdown to HTML.
+	Body string
+	Type string
+	Slot string
+}
+
+type MessagesFragmentData struct {
+	Lang string
+	// Dir is the text direction of the language, ltr or rtl.
+	Dir      string
+	Messages []FragmentMessage
+}
@@ -0,0 +1,30 @@
+package languages
+
+import v "github.com/anthdm/superkit/validate"
+
+type IndexPageData struct {
+	LanguagesList []*LanguageListItem
+	FormValues    *LanguageFormValues
+	FormErrors    v.Errors
+}
+
+type PageLanguageEditData struct {
+	FormValues *LanguageFormValues
+	FormErrors v.Errors
+}
+
+type LanguageListItem struct {
+	ID        int64
+	Code      string
+	Name      string
+	Direction string
+	Enabled   bool
+}
+
+type LanguageFormValues struct {
+	ID        int64  `form:"id"`
+	Code      string `form:"code"`
+	Name      string `form:"name"`
+	Direction string `form:"direction"`
+	Enabled   bool   `form:"enabled"`
+}
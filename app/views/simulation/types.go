@@ -0,0 +1,55 @@
+package simulation
+
+import (
+	"time"
+
+	v "github.com/anthdm/superkit/validate"
+)
+
+type IndexPageData struct {
+	FormValues *FormValues
+	FormErrors v.Errors
+	// Websites and Languages list the choices of the form, by id and by code.
+	Websites  map[string]string
+	Languages map[string]string
+	// Timezone is the timezone of the dates of the form and of the results.
+	Timezone string
+	// Result is set once a simulation was run.
+	Result *Result
+}
+
+type FormValues struct {
+	WebsiteId string `form:"website"`
+	Language  string `form:"lang"`
+	At        string `form:"at"`
+	Days      string `form:"days"`
+	Path      string `form:"path"`
+}
+
+// Result describes the messages served at the instant of a simulation and
+// the changes of that set until its end.
+type Result struct {
+	At       time.Time
+	Until    time.Time
+	Language string
+	Messages []*Message
+	Timeline []*Change
+}
+
+type Message struct {
+	ID          int64
+	Title       string
+	Type        string
+	Slot        string
+	DisplayFrom time.Time
+	DisplayTo   time.Time
+}
+
+// Change is an instant at which the messages served change.
+type Change struct {
+	At       time.Time
+	Language string
+	Added    []*Message
+	Removed  []*Message
+	Count    int
+}
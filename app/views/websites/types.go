@@ -1,28 +1,78 @@
 package websites
 
-import v "github.com/anthdm/superkit/validate"
+import (
+	"time"
+
+	v "github.com/anthdm/superkit/validate"
+)
 
 type IndexPageData struct {
 	WebsitesList []*WebsiteListItem
 	FormValues   *WebsiteFormValues
+	FormSettings *WebsiteFormSettings
 	FormErrors   v.Errors
 }
 
 type PageWebsiteEditData struct {
-	FormValues *WebsiteFormValues
-	FormErrors v.Errors
+	FormValues       *WebsiteFormValues
+	FormSettings     *WebsiteFormSettings
+	FormErrors       v.Errors
+	ApiKeys          *ApiKeysSectionData
+	WidgetScriptURL  string
+	RejectedRequests int64
 }
 
 type WebsiteListItem struct {
-	ID      int64
-	Name    string
-	Domain  string
-	Staging bool
+	ID            int64
+	Name          string
+	Domain        string
+	DomainAliases []string
+	Staging       bool
 }
 
 type WebsiteFormValues struct {
-	ID      int64  `form:"id"`
-	Name    string `form:"name"`
-	Domain  string `form:"domain"`
-	Staging bool   `form:"staging"`
+	ID                int64  `form:"id"`
+	Name              string `form:"name"`
+	Domain            string `form:"domain"`
+	DomainAliases     string `form:"domain_aliases"`
+	Staging           bool   `form:"staging"`
+	AllowOriginLookup bool   `form:"allow_origin_lookup"`
+	FallbackLanguage  string `form:"fallback_language"`
+	Timezone          string `form:"timezone"`
+	ForbidLinks       bool   `form:"forbid_links"`
+	ForbidImages      bool   `form:"forbid_images"`
+	LinkTracking      bool   `form:"link_tracking"`
+	AllowedURLSchemes string `form:"allowed_url_schemes"`
+	CorsOrigins       string `form:"cors_origins"`
+	CorsMaxAge        int    `form:"cors_max_age"`
+	RateLimit         int    `form:"rate_limit"`
+	RateBurst         int    `form:"rate_burst"`
+	Slots             string `form:"slots"`
+}
+
+type WebsiteFormSettings struct {
+	Languages map[string]string
+	// Timezones lists the timezones suggested for the website.
+	Timezones []string
+}
+
+type ApiKeysSectionData struct {
+	WebsiteID   int64
+	ApiKeys     []*ApiKeyListItem
+	RevealedKey string
+	FormValues  *ApiKeyFormValues
+	FormErrors  v.Errors
+}
+
+type ApiKeyListItem struct {
+	ID         int64
+	Name       string
+	Prefix     string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	RevokedAt  time.Time
+}
+
+type ApiKeyFormValues struct {
+	Name string `form:"name"`
 }
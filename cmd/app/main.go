@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"messages/app"
@@ -8,6 +10,9 @@ import (
 	"messages/public"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/anthdm/superkit/kit"
 	"github.com/go-chi/chi/v5"
@@ -15,6 +20,10 @@ import (
 	"github.com/joho/godotenv"
 )
 
+// shutdownTimeout bounds the time given to the requests in progress, such as
+// open streams, when the server shuts down.
+const shutdownTimeout = 10 * time.Second
+
 func main() {
 	kit.Setup()
 
@@ -47,7 +56,25 @@ func main() {
 
 	fmt.Printf("application running in %s at %s\n", kit.Env(), url)
 
-	http.ListenAndServe(listenAddr, router)
+	server := &http.Server{Addr: listenAddr, Handler: router}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal(err)
+		}
+	}()
+
+	// On SIGINT or SIGTERM, such as a redeploy, the requests in progress are
+	// given some time to complete before the state held in memory is saved.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("error shutting down the server: %v", err)
+	}
+	app.Shutdown()
 }
 
 func staticDev() http.Handler {
@@ -91,7 +91,7 @@ func HandleAuthCreate(kit *kit.Kit) error {
 	session := &models.Session{
 		UserID:    user.ID,
 		Token:     uuid.New().String(),
-		ExpiresAt: time.Now().Add(time.Hour * time.Duration(sessionExpiry)),
+		ExpiresAt: time.Now().UTC().Add(time.Hour * time.Duration(sessionExpiry)),
 	}
 	session.Insert(kit.Request.Context(), db.Query, boil.Infer())
 
@@ -185,8 +185,6 @@ func HandleSignupCreate(kit *kit.Kit) error {
 }
 
 func AuthenticateUser(kit *kit.Kit) (kit.Auth, error) {
-	loc, _ := time.LoadLocation(kit.Getenv("TIMEZONE", "America/Toronto"))
-
 	auth := Auth{}
 	sess := kit.GetSession(userSessionName)
 	token, ok := sess.Values["sessionToken"]
@@ -196,7 +194,7 @@ func AuthenticateUser(kit *kit.Kit) (kit.Auth, error) {
 
 	session, err := models.Sessions(
 		models.SessionWhere.Token.EQ(token.(string)),
-		models.SessionWhere.ExpiresAt.GT(time.Now().In(loc)),
+		models.SessionWhere.ExpiresAt.GT(time.Now().UTC()),
 		qm.Load(models.SessionRels.User),
 	).One(kit.Request.Context(), db.Query)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"messages/app/db"
 	"messages/app/models"
+	"messages/app/schedule"
 
 	"github.com/anthdm/superkit/kit"
 	v "github.com/anthdm/superkit/validate"
@@ -12,6 +13,7 @@ import (
 var profileSchema = v.Schema{
 	"firstName": v.Rules(v.Min(3), v.Max(50)),
 	"lastName":  v.Rules(v.Min(3), v.Max(50)),
+	"timezone":  v.Rules(),
 }
 
 type ProfileFormValues struct {
@@ -19,6 +21,7 @@ type ProfileFormValues struct {
 	FirstName string `form:"firstName"`
 	LastName  string `form:"lastName"`
 	Email     string `form:"email"`
+	Timezone  string `form:"timezone"`
 	Success   string
 	Role      string
 }
@@ -38,6 +41,7 @@ func HandleProfileShow(kit *kit.Kit) error {
 		FirstName: user.FirstName,
 		LastName:  user.LastName,
 		Email:     user.Email,
+		Timezone:  user.Timezone,
 		Role:      auth.Role,
 	}
 
@@ -51,6 +55,13 @@ func HandleProfileUpdate(kit *kit.Kit) error {
 		return kit.Render(ProfileForm(values, errors))
 	}
 
+	if values.Timezone != "" {
+		if _, err := schedule.LoadLocation(values.Timezone); err != nil {
+			errors.Add("timezone", err.Error())
+			return kit.Render(ProfileForm(values, errors))
+		}
+	}
+
 	auth := kit.Auth().(Auth)
 	if auth.UserID != values.ID {
 		return fmt.Errorf("unauthorized request for profile %d", values.ID)
@@ -62,6 +73,7 @@ func HandleProfileUpdate(kit *kit.Kit) error {
 		models.UserColumns.FirstName: values.FirstName,
 		models.UserColumns.LastName:  values.LastName,
 		models.UserColumns.Email:     values.Email,
+		models.UserColumns.Timezone:  values.Timezone,
 	})
 	if err != nil {
 		return err
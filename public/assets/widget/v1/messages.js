@@ -0,0 +1,228 @@
+/*!
+ * Messages widget v1
+ *
+ * Displays the messages of a website as banners. Usage:
+ *
+ *   <script src="https://messages.example.com/public/assets/widget/v1/messages.js"
+ *     data-api-key="msk_..." async></script>
+ *
+ * Options (data attributes of the script tag):
+ *   data-api-key      API key of the website (required unless the website allows Origin lookup)
+ *   data-lang         Language of the messages (defaults to the page language, then to the browser languages)
+ *   data-target       CSS selector of the element receiving the banners (defaults to the top of <body>)
+ *   data-slot         Slots of the website to display, separated by commas (defaults to every slot)
+ *   data-endpoint     Base URL of the Messages server (defaults to the origin of this script)
+ *   data-dismissible  "false" to hide the close button
+ *   data-live         "true" to receive updates without reloading the page
+ *   data-analytics    "false" to stop reporting views, link clicks and dismissals
+ *   data-preview      Preview token, also read from the messages_preview parameter of the page URL
+ *
+ * Single-page applications should call MessagesWidget.reload() after each
+ * navigation, as messages can target some pages only.
+ */
+(function () {
+  "use strict";
+
+  var VERSION = "1.7.0";
+  var STORAGE_KEY = "messages-widget:dismissed";
+  var STYLES = {
+    info: { background: "#e0f2fe", border: "#0284c7", color: "#0c4a6e" },
+    warning: { background: "#fef9c3", border: "#ca8a04", color: "#713f12" },
+    danger: { background: "#fee2e2", border: "#dc2626", color: "#7f1d1d" },
+  };
+
+  var script = document.currentScript;
+  if (!script) {
+    return;
+  }
+
+  var config = {
+    apiKey: script.getAttribute("data-api-key") || "",
+    lang: script.getAttribute("data-lang") || document.documentElement.lang || "",
+    target: script.getAttribute("data-target") || "",
+    slot: script.getAttribute("data-slot") || "",
+    endpoint: (script.getAttribute("data-endpoint") || new URL(script.src).origin).replace(/\/+$/, ""),
+    dismissible: script.getAttribute("data-dismissible") !== "false",
+    live: script.getAttribute("data-live") === "true",
+    analytics: script.getAttribute("data-analytics") !== "false",
+    preview: script.getAttribute("data-preview") || new URLSearchParams(window.location.search).get("messages_preview") || "",
+  };
+
+  // Previews show every message, and are not counted.
+  if (config.preview) {
+    config.analytics = false;
+  }
+
+  // Keys of the messages whose view was already reported by this page.
+  var viewed = {};
+
+  // A dismissed message is displayed again once edited, as its revision changes.
+  function messageKey(message) {
+    return message.id + ":" + message.revision;
+  }
+
+  function getDismissed() {
+    try {
+      return JSON.parse(localStorage.getItem(STORAGE_KEY)) || [];
+    } catch (e) {
+      return [];
+    }
+  }
+
+  function dismiss(key) {
+    var dismissed = getDismissed();
+    if (dismissed.indexOf(key) === -1) {
+      dismissed.push(key);
+    }
+    try {
+      localStorage.setItem(STORAGE_KEY, JSON.stringify(dismissed.slice(-100)));
+    } catch (e) {}
+  }
+
+  // Reports events about messages, surviving the page being closed. Beacons
+  // are sent as text/plain so they need no preflight request.
+  function report(type, messages) {
+    if (!config.analytics || messages.length === 0) {
+      return;
+    }
+    var body = JSON.stringify({
+      events: messages.map(function (message) {
+        return { type: type, id: message.id, language: message.language };
+      }),
+    });
+    var endpoint = url("/api/v1/messages/events");
+    if (navigator.sendBeacon && navigator.sendBeacon(endpoint, body)) {
+      return;
+    }
+    fetch(endpoint, { method: "POST", body: body, keepalive: true }).catch(function () {});
+  }
+
+  function getContainer() {
+    var container = config.target ? document.querySelector(config.target) : null;
+    if (container) {
+      return container;
+    }
+
+    container = document.getElementById("messages-widget");
+    if (!container) {
+      container = document.createElement("div");
+      container.id = "messages-widget";
+      document.body.insertBefore(container, document.body.firstChild);
+    }
+    return container;
+  }
+
+  function renderBanner(message) {
+    var key = messageKey(message);
+    var style = STYLES[message.type] || STYLES.info;
+
+    var banner = document.createElement("div");
+    banner.className = "messages-widget__banner messages-widget__banner--" + message.type;
+    banner.setAttribute("role", message.type === "danger" ? "alert" : "status");
+    banner.style.cssText =
+      "position:relative;padding:12px 40px 12px 16px;border-left:4px solid " + style.border +
+      ";background:" + style.background + ";color:" + style.color + ";font-family:inherit;font-size:14px;line-height:1.4;";
+
+    var title = document.createElement("strong");
+    title.className = "messages-widget__title";
+    title.textContent = message.title;
+    banner.appendChild(title);
+
+    var content = document.createElement("div");
+    content.className = "messages-widget__content";
+    content.innerHTML = message.message;
+    content.addEventListener("click", function (event) {
+      if (event.target.closest && event.target.closest("a")) {
+        report("click", [message]);
+      }
+    });
+    banner.appendChild(content);
+
+    if (config.dismissible) {
+      var close = document.createElement("button");
+      close.type = "button";
+      close.className = "messages-widget__close";
+      close.setAttribute("aria-label", "Close");
+      close.textContent = "×";
+      close.style.cssText =
+        "position:absolute;top:8px;right:12px;border:0;background:none;color:inherit;font-size:20px;line-height:1;cursor:pointer;";
+      close.addEventListener("click", function () {
+        dismiss(key);
+        report("dismissal", [message]);
+        banner.parentNode.removeChild(banner);
+      });
+      banner.appendChild(close);
+    }
+
+    return banner;
+  }
+
+  function render(messages) {
+    var container = getContainer();
+    var dismissed = getDismissed();
+
+    var views = [];
+    container.innerHTML = "";
+    messages.forEach(function (message) {
+      var key = messageKey(message);
+      if (config.preview || dismissed.indexOf(key) === -1) {
+        container.appendChild(renderBanner(message));
+        if (!viewed[key]) {
+          viewed[key] = true;
+          views.push(message);
+        }
+      }
+    });
+    report("impression", views);
+  }
+
+  function url(path) {
+    var params = new URLSearchParams();
+    // Without a language, the server negotiates from the Accept-Language header.
+    if (config.lang) {
+      params.set("lang", config.lang);
+    }
+    if (config.apiKey) {
+      params.set("api_key", config.apiKey);
+    }
+    if (config.slot) {
+      params.set("slot", config.slot);
+    }
+    if (config.preview) {
+      params.set("preview", config.preview);
+    }
+    // Messages can target some pages of the website only.
+    params.set("path", window.location.pathname);
+    return config.endpoint + path + "?" + params.toString();
+  }
+
+  function load() {
+    fetch(url("/api/v1/messages"))
+      .then(function (response) {
+        return response.json();
+      })
+      .then(function (payload) {
+        render(payload.messages || []);
+      })
+      .catch(function () {});
+  }
+
+  function start() {
+    if (config.live && window.EventSource) {
+      var source = new EventSource(url("/api/v1/messages/stream"));
+      source.addEventListener("messages", function (event) {
+        render(JSON.parse(event.data).messages || []);
+      });
+      return;
+    }
+    load();
+  }
+
+  window.MessagesWidget = { version: VERSION, reload: load };
+
+  if (document.readyState === "loading") {
+    document.addEventListener("DOMContentLoaded", start);
+  } else {
+    start();
+  }
+})();